@@ -110,6 +110,10 @@ type Proto struct {
 	// ThirdPartyPath is the relative path of where the third party proto files are
 	// located that used by the app.
 	ThirdPartyPaths []string `yaml:"third_party_paths"`
+
+	// Workers is the max number of modules to generate code for in parallel.
+	// Defaults to the number of CPUs when not set.
+	Workers int `yaml:"workers"`
 }
 
 // Client configures code generation for clients.
@@ -161,6 +165,41 @@ type Faucet struct {
 
 	// Port number for faucet server to listen at.
 	Port int `yaml:"port"`
+
+	// IPRateLimit is the max number of requests that a single IP can make within
+	// RequestRateWindow.
+	IPRateLimit int `yaml:"ip_rate_limit"`
+
+	// GlobalRateLimit is the max number of requests that the faucet accepts from
+	// all clients within RequestRateWindow.
+	GlobalRateLimit int `yaml:"global_rate_limit"`
+
+	// RequestRateWindow is the window that the request rate limits are applied for.
+	RequestRateWindow string `yaml:"request_rate_window"`
+
+	// AllowAddresses only allows transfers to these addresses when it is not empty.
+	AllowAddresses []string `yaml:"allow_addresses"`
+
+	// DenyAddresses denies transfers to these addresses.
+	DenyAddresses []string `yaml:"deny_addresses"`
+
+	// AllowCIDRs only allows requests from the IPs in these ranges when it is not empty.
+	AllowCIDRs []string `yaml:"allow_cidrs"`
+
+	// DenyCIDRs denies requests from the IPs in these ranges.
+	DenyCIDRs []string `yaml:"deny_cidrs"`
+
+	// PoWDifficulty requires clients to solve a proof-of-work challenge with this
+	// many leading zero bits before requesting tokens.
+	PoWDifficulty int `yaml:"pow_difficulty"`
+
+	// AccessLog is the path of the file to write the JSON access log of the faucet
+	// server to. the path is relative to the app's directory.
+	AccessLog string `yaml:"access_log"`
+
+	// AdminToken enables the admin endpoints of the faucet server that are
+	// authorized with this token.
+	AdminToken string `yaml:"admin_token"`
 }
 
 // Init overwrites sdk configurations with given values.
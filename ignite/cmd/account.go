@@ -1,20 +1,16 @@
 package ignitecmd
 
 import (
-	"os"
-
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/cliquiz"
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/entrywriter"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 )
 
 const (
 	flagAddressPrefix  = "address-prefix"
 	flagPassphrase     = "passphrase"
-	flagNonInteractive = "non-interactive"
 	flagKeyringBackend = "keyring-backend"
 	flagFrom           = "from"
 )
@@ -39,12 +35,34 @@ Ignite CLI uses accounts to interact with the Ignite blockchain, use an IBC rela
 	return c
 }
 
+// accountResult is an account printed in JSON output.
+type accountResult struct {
+	Name      string `json:"name"`
+	Address   string `json:"address"`
+	PublicKey string `json:"public_key"`
+}
+
 func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) error {
-	var accEntries [][]string
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	var (
+		accEntries [][]string
+		results    = make([]accountResult, 0, len(accounts))
+	)
 	for _, acc := range accounts {
-		accEntries = append(accEntries, []string{acc.Name, acc.Address(getAddressPrefix(cmd)), acc.PubKey()})
+		result := accountResult{
+			Name:      acc.Name,
+			Address:   acc.Address(getAddressPrefix(cmd)),
+			PublicKey: acc.PubKey(),
+		}
+		results = append(results, result)
+		accEntries = append(accEntries, []string{result.Name, result.Address, result.PublicKey})
 	}
-	return entrywriter.MustWrite(os.Stdout, []string{"name", "address", "public key"}, accEntries...)
+
+	return session.PrintResult(results, func() error {
+		return session.PrintTable([]string{"name", "address", "public key"}, accEntries...)
+	})
 }
 
 func flagSetKeyringBackend() *flag.FlagSet {
@@ -76,21 +94,19 @@ func getFrom(cmd *cobra.Command) string {
 
 func flagSetAccountImportExport() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
-	fs.Bool(flagNonInteractive, false, "Do not enter into interactive mode")
 	fs.String(flagPassphrase, "", "Account passphrase")
 	return fs
 }
 
-func getIsNonInteractive(cmd *cobra.Command) bool {
-	is, _ := cmd.Flags().GetBool(flagNonInteractive)
-	return is
-}
-
 func getPassphrase(cmd *cobra.Command) (string, error) {
 	pass, _ := cmd.Flags().GetString(flagPassphrase)
 
-	if pass == "" && !getIsNonInteractive(cmd) {
-		if err := cliquiz.Ask(
+	if pass == "" {
+		quiz, err := newQuiz(cmd)
+		if err != nil {
+			return "", err
+		}
+		if err := quiz.Ask(
 			cliquiz.NewQuestion("Passphrase",
 				&pass,
 				cliquiz.HideAnswer(),
@@ -1,13 +1,17 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 )
 
+// accountCreateResult is a created account printed in JSON output.
+type accountCreateResult struct {
+	accountResult
+	Mnemonic string `json:"mnemonic"`
+}
+
 func NewAccountCreate() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "create [name]",
@@ -17,6 +21,7 @@ func NewAccountCreate() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
 
 	return c
 }
@@ -31,11 +36,24 @@ func accountCreateHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	_, mnemonic, err := ca.Create(name)
+	acc, mnemonic, err := ca.Create(name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Account %q created, keep your mnemonic in a secret place:\n\n%s\n", name, mnemonic)
-	return nil
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	result := accountCreateResult{
+		accountResult: accountResult{
+			Name:      acc.Name,
+			Address:   acc.Address(getAddressPrefix(cmd)),
+			PublicKey: acc.PubKey(),
+		},
+		Mnemonic: mnemonic,
+	}
+
+	return session.PrintResult(result, func() error {
+		return session.Printf("Account %q created, keep your mnemonic in a secret place:\n\n%s\n", name, mnemonic)
+	})
 }
@@ -1,13 +1,16 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 )
 
+// accountDeleteResult is a deleted account printed in JSON output.
+type accountDeleteResult struct {
+	Name string `json:"name"`
+}
+
 func NewAccountDelete() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "delete [name]",
@@ -35,6 +38,10 @@ func accountDeleteHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("Account %s deleted.\n", name)
-	return nil
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	return session.PrintResult(accountDeleteResult{Name: name}, func() error {
+		return session.Printf("Account %s deleted.\n", name)
+	})
 }
@@ -10,6 +10,12 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 )
 
+// accountExportResult is an exported account printed in JSON output.
+type accountExportResult struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
 func NewAccountExport() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "export [name]",
@@ -60,6 +66,10 @@ func accountExportHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("Account %q exported to file: %s\n", name, path)
-	return nil
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	return session.PrintResult(accountExportResult{Name: name, Path: path}, func() error {
+		return session.Printf("Account %q exported to file: %s\n", name, path)
+	})
 }
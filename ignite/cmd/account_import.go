@@ -2,7 +2,6 @@ package ignitecmd
 
 import (
 	"errors"
-	"fmt"
 	"os"
 
 	"github.com/cosmos/go-bip39"
@@ -24,6 +23,7 @@ func NewAccountImport() *cobra.Command {
 
 	c.Flags().String(flagSecret, "", "Your mnemonic or path to your private key (use interactive mode instead to securely pass your mnemonic)")
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
 	c.Flags().AddFlagSet(flagSetAccountImportExport())
 
 	return c
@@ -36,8 +36,16 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 	)
 
 	if secret == "" {
-		if err := cliquiz.Ask(
-			cliquiz.NewQuestion("Your mnemonic or path to your private key", &secret, cliquiz.Required())); err != nil {
+		quiz, err := newQuiz(cmd)
+		if err != nil {
+			return err
+		}
+		if err := quiz.Ask(
+			cliquiz.NewQuestion("Your mnemonic or path to your private key",
+				&secret,
+				cliquiz.Required(),
+				cliquiz.Key(flagSecret),
+			)); err != nil {
 			return err
 		}
 	}
@@ -65,10 +73,21 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if _, err := ca.Import(name, secret, passphrase); err != nil {
+	acc, err := ca.Import(name, secret, passphrase)
+	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Account %q imported.\n", name)
-	return nil
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	result := accountResult{
+		Name:      acc.Name,
+		Address:   acc.Address(getAddressPrefix(cmd)),
+		PublicKey: acc.PubKey(),
+	}
+
+	return session.PrintResult(result, func() error {
+		return session.Printf("Account %q imported.\n", name)
+	})
 }
@@ -18,7 +18,10 @@ func NewChain() *cobra.Command {
 		NewChainBuild(),
 		NewChainInit(),
 		NewChainFaucet(),
+		NewChainFaucetServer(),
+		NewChainIBCPlayground(),
 		NewChainSimulate(),
+		NewChainLint(),
 	)
 
 	return c
@@ -1,7 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -18,6 +17,13 @@ const (
 	flagReleasePrefix  = "release.prefix"
 )
 
+// buildResult is the result of the build printed in JSON output.
+type buildResult struct {
+	BinaryName  string `json:"binary_name,omitempty"`
+	BinaryPath  string `json:"binary_path,omitempty"`
+	ReleasePath string `json:"release_path,omitempty"`
+}
+
 // NewChainBuild returns a new build command to build a blockchain app.
 func NewChainBuild() *cobra.Command {
 	c := &cobra.Command{
@@ -78,15 +84,18 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	session := newSession(cmd)
+	defer session.Cleanup()
+
 	if isRelease {
 		releasePath, err := c.BuildRelease(cmd.Context(), cacheStorage, output, releasePrefix, releaseTargets...)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("🗃  Release created: %s\n", colors.Info(releasePath))
-
-		return nil
+		return session.PrintResult(buildResult{ReleasePath: releasePath}, func() error {
+			return session.Printf("🗃  Release created: %s\n", colors.Info(releasePath))
+		})
 	}
 
 	binaryName, err := c.Build(cmd.Context(), cacheStorage, output)
@@ -94,12 +103,15 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if output == "" {
-		fmt.Printf("🗃  Installed. Use with: %s\n", colors.Info(binaryName))
-	} else {
-		binaryPath := filepath.Join(output, binaryName)
-		fmt.Printf("🗃  Binary built at the path: %s\n", colors.Info(binaryPath))
+	result := buildResult{BinaryName: binaryName}
+	if output != "" {
+		result.BinaryPath = filepath.Join(output, binaryName)
 	}
 
-	return nil
+	return session.PrintResult(result, func() error {
+		if output == "" {
+			return session.Printf("🗃  Installed. Use with: %s\n", colors.Info(binaryName))
+		}
+		return session.Printf("🗃  Binary built at the path: %s\n", colors.Info(result.BinaryPath))
+	})
 }
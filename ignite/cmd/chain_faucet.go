@@ -1,8 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
@@ -10,6 +8,12 @@ import (
 	"github.com/ignite-hq/cli/ignite/services/chain"
 )
 
+// faucetTransferResult is a transfer of the faucet printed in JSON output.
+type faucetTransferResult struct {
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
+}
+
 // NewChainFaucet creates a new faucet command to send coins to accounts.
 func NewChainFaucet() *cobra.Command {
 	c := &cobra.Command{
@@ -58,6 +62,12 @@ func chainFaucetHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Println("📨 Coins sent.")
-	return nil
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	result := faucetTransferResult{Address: toAddress, Coins: parsedCoins.String()}
+
+	return session.PrintResult(result, func() error {
+		return session.Println("📨 Coins sent.")
+	})
 }
@@ -0,0 +1,110 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite-hq/cli/ignite/pkg/xhttp"
+	"github.com/ignite-hq/cli/ignite/pkg/xurl"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+const flagFaucetHost = "host"
+
+// faucetServerResult is a served faucet printed in JSON output.
+type faucetServerResult struct {
+	ChainID string `json:"chain_id"`
+	URL     string `json:"url"`
+}
+
+// NewChainFaucetServer creates a new command to serve the faucets of multiple chains.
+func NewChainFaucetServer() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "faucet-server [path] [...]",
+		Short: "Serve the faucets of one or more chains from a single server",
+		Long: `Serve the faucets of one or more running chains from a single server.
+
+Each path is the directory of a chain which has a faucet configured in its config.yml.
+The faucet of a chain uses the account, coins and limits of its config.yml and it is
+served under /chains/<chain-id>. Clients can list the served chains from /info.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: chainFaucetServerHandler,
+	}
+
+	c.Flags().String(flagFaucetHost, ":4500", "Host and port to serve the faucets at")
+	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+
+	return c
+}
+
+func chainFaucetServerHandler(cmd *cobra.Command, args []string) error {
+	host, _ := cmd.Flags().GetString(flagFaucetHost)
+
+	chainOption := []chain.Option{
+		chain.LogLevel(logLevel(cmd)),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	}
+
+	var faucets []cosmosfaucet.Faucet
+
+	for _, path := range args {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+
+		c, err := chain.New(absPath, chainOption...)
+		if err != nil {
+			return err
+		}
+
+		faucet, err := c.Faucet(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		faucets = append(faucets, faucet)
+	}
+
+	server, err := cosmosfaucet.NewServer(faucets...)
+	if err != nil {
+		return err
+	}
+
+	addr, err := xurl.HTTP(host)
+	if err != nil {
+		return err
+	}
+
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	results := make([]faucetServerResult, 0, len(faucets))
+	for _, faucet := range faucets {
+		results = append(results, faucetServerResult{
+			ChainID: faucet.ChainID(),
+			URL:     addr + cosmosfaucet.ChainPath(faucet.ChainID()),
+		})
+	}
+
+	if err := session.PrintResult(results, func() error {
+		for _, result := range results {
+			if err := session.Printf("🌍 Token faucet for %s: %s\n", result.ChainID, result.URL); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return xhttp.Serve(cmd.Context(), &http.Server{
+		Addr:    host,
+		Handler: server,
+	})
+}
@@ -0,0 +1,463 @@
+package ignitecmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/availableport"
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+	"github.com/ignite-hq/cli/ignite/pkg/xurl"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+const (
+	// playgroundDir is the dir in the Ignite config dir that keeps the homes
+	// and the caches of the chains served by the IBC playground.
+	playgroundDir = "ibc-playground"
+
+	// playgroundPortsPerChain is the number of ports used by a chain: RPC, P2P, Prof,
+	// gRPC, gRPC-Web, API and faucet.
+	playgroundPortsPerChain = 7
+
+	// playgroundRetryInterval is the interval to check if a chain is ready.
+	playgroundRetryInterval = time.Second
+
+	// playgroundFaucetRetries is the number of attempts to receive tokens from a faucet.
+	playgroundFaucetRetries = 10
+)
+
+var playgroundChainsHeader = []string{"Chain", "App", "Tendermint node", "Blockchain API", "gRPC", "Token faucet"}
+
+var playgroundPathsHeader = []string{"Path", "Source", "Target"}
+
+// playgroundChain is a chain served by the IBC playground.
+type playgroundChain struct {
+	*chain.Chain
+	id     string
+	config chainconfig.Config
+	cache  cache.Storage
+}
+
+// NewChainIBCPlayground returns a new command to serve two chains on free ports and relay
+// packets between them through a transfer channel and the channels of their IBC modules.
+func NewChainIBCPlayground() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ibc-playground [app-a] [app-b]",
+		Short: "Serve two blockchains connected with IBC and relay packets between them",
+		Long: `Serve two blockchains connected with IBC and relay packets between them.
+
+The apps are served with their state reset on ports that are not in use, so no "host" config
+is needed. Relayer accounts receive tokens from the faucets of the chains and channels are opened
+for the "transfer" port and for the ports of the IBC modules scaffolded in both apps.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: chainIBCPlaygroundHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+
+	return c
+}
+
+func chainIBCPlaygroundHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := ca.EnsureDefaultAccount(); err != nil {
+		return err
+	}
+
+	ports, err := availableport.Find(playgroundPortsPerChain * len(args))
+	if err != nil {
+		return err
+	}
+
+	var chains []*playgroundChain
+
+	for i, appPath := range args {
+		c, err := newPlaygroundChain(cmd, appPath, ports[i*playgroundPortsPerChain:(i+1)*playgroundPortsPerChain])
+		if err != nil {
+			return err
+		}
+		chains = append(chains, c)
+	}
+
+	if chains[0].id == chains[1].id {
+		return fmt.Errorf("both apps have the chain id %q, IBC requires chains with different ids", chains[0].id)
+	}
+
+	g, ctx := errgroup.WithContext(cmd.Context())
+
+	// the state is reset on start because the relayer paths are created from scratch.
+	for _, c := range chains {
+		c := c
+		g.Go(func() error {
+			return c.Serve(ctx, c.cache, chain.ServeResetOnce())
+		})
+	}
+
+	g.Go(func() error {
+		defer session.StopSpinner()
+
+		session.StartSpinner("Building and starting the chains (use -v to see their logs)...")
+
+		for _, c := range chains {
+			if err := waitForChain(ctx, c.id, c.rpcAddress()); err != nil {
+				return err
+			}
+		}
+
+		relayerConfigPath, err := playgroundRelayerConfigPath()
+		if err != nil {
+			return err
+		}
+
+		r := relayer.New(
+			ca,
+			relayer.CollectEvents(session.EventBus()),
+			relayer.WithConfigPath(relayerConfigPath),
+		)
+
+		pathIDs, err := connectPlaygroundChains(ctx, session, r, chains)
+		if err != nil {
+			return err
+		}
+
+		session.StartSpinner("Opening channels...")
+
+		if err := r.Link(ctx, pathIDs...); err != nil {
+			return err
+		}
+
+		session.StopSpinner()
+
+		if err := printPlaygroundSummary(ctx, session, r, chains, pathIDs); err != nil {
+			return err
+		}
+
+		return r.Start(ctx, pathIDs...)
+	})
+
+	return g.Wait()
+}
+
+// newPlaygroundChain creates a chain for the app at appPath that serves on ports.
+func newPlaygroundChain(cmd *cobra.Command, appPath string, ports []int) (*playgroundChain, error) {
+	absPath, err := filepath.Abs(appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	address := func(port int) string {
+		return fmt.Sprintf("0.0.0.0:%d", port)
+	}
+
+	host := chainconfig.Host{
+		RPC:     address(ports[0]),
+		P2P:     address(ports[1]),
+		Prof:    address(ports[2]),
+		GRPC:    address(ports[3]),
+		GRPCWeb: address(ports[4]),
+		API:     address(ports[5]),
+	}
+
+	logLevel := chain.LogSilent
+	if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+		logLevel = chain.LogVerbose
+	}
+
+	c, err := chain.New(absPath,
+		chain.LogLevel(logLevel),
+		chain.Host(host),
+		chain.FaucetHost(address(ports[6])),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Faucet.Name == nil {
+		return nil, fmt.Errorf("%s: the faucet must be enabled in the config to fund the relayer account", id)
+	}
+
+	// playground chains don't share the homes and the caches of the chains served
+	// by "chain serve", so their states are kept intact.
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(configDir, playgroundDir, id)
+	c.SetHome(filepath.Join(dir, "home"))
+
+	storage, err := cache.NewStorage(filepath.Join(dir, cacheFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	if flagGetClearCache(cmd) {
+		if err := storage.Clear(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &playgroundChain{
+		Chain:  c,
+		id:     id,
+		config: config,
+		cache:  storage,
+	}, nil
+}
+
+// rpcAddress returns the address of the chain's Tendermint RPC.
+func (c *playgroundChain) rpcAddress() string {
+	addr, _ := xurl.HTTP(c.config.Host.RPC)
+	return addr
+}
+
+// grpcAddress returns the gRPC address of the chain's node.
+func (c *playgroundChain) grpcAddress() string {
+	addr, _ := xurl.HTTP(c.config.Host.GRPC)
+	return addr
+}
+
+// faucetAddress returns the address of the chain's faucet.
+func (c *playgroundChain) faucetAddress() string {
+	addr, _ := xurl.HTTP(chainconfig.FaucetHost(c.config))
+	return addr
+}
+
+// waitForChain waits until the chain with id served at rpcAddress produces its first block.
+func waitForChain(ctx context.Context, id, rpcAddress string) error {
+	client, err := rpchttp.New(rpcAddress, "/websocket")
+	if err != nil {
+		return err
+	}
+
+	started := func() error {
+		status, err := client.Status(ctx)
+		if err != nil {
+			return err
+		}
+		if status.SyncInfo.LatestBlockHeight < 1 {
+			return fmt.Errorf("%s: no blocks produced yet", id)
+		}
+		return nil
+	}
+
+	return backoff.Retry(started, backoff.WithContext(backoff.NewConstantBackOff(playgroundRetryInterval), ctx))
+}
+
+// playgroundRelayerConfigPath returns the path of the relayer config of the playground, it's
+// kept apart from the relayer config of the user since the playground resets its chains and paths.
+func playgroundRelayerConfigPath() (string, error) {
+	dir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "relayer", "playground.yml"), nil
+}
+
+// connectPlaygroundChains sets up the relayer accounts of the chains and creates paths for
+// the transfer port and for the ports of the IBC modules of both apps.
+func connectPlaygroundChains(
+	ctx context.Context,
+	session cliui.Session,
+	r relayer.Relayer,
+	chains []*playgroundChain,
+) (pathIDs []string, err error) {
+	// paths from previous runs are stale because the states of the chains are reset,
+	// they are only removed from the relayer config of the playground.
+	if err := r.RemoveChains(ctx, chains[0].id, chains[1].id); err != nil {
+		return nil, err
+	}
+
+	var relayerChains []*relayer.Chain
+
+	for _, c := range chains {
+		session.StartSpinner(fmt.Sprintf("Setting up the relayer account on %q...", c.id))
+
+		addressPrefix, err := c.AddressPrefix(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// the chains accept txs without fees.
+		staked, err := sdk.ParseCoinNormalized(c.config.Validator.Staked)
+		if err != nil {
+			return nil, err
+		}
+
+		rc, _, err := r.NewChain(
+			ctx,
+			cosmosaccount.DefaultAccount,
+			c.rpcAddress(),
+			relayer.WithFaucet(c.faucetAddress()),
+			relayer.WithGRPCAddress(c.grpcAddress()),
+			relayer.WithGasPrice(sdk.NewInt64Coin(staked.Denom, 0).String()),
+			relayer.WithAddressPrefix(addressPrefix),
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot resolve %s", c.id)
+		}
+
+		// the faucet may start serving after the first block.
+		retrieve := func() error {
+			_, err := rc.TryRetrieve(ctx)
+			return err
+		}
+		retry := backoff.WithMaxRetries(backoff.NewConstantBackOff(playgroundRetryInterval), playgroundFaucetRetries)
+		if err := backoff.Retry(retrieve, backoff.WithContext(retry, ctx)); err != nil {
+			return nil, errors.Wrapf(err, "cannot receive tokens from the faucet of %s", c.id)
+		}
+
+		relayerChains = append(relayerChains, rc)
+	}
+
+	src, dst := relayerChains[0], relayerChains[1]
+
+	id, err := src.Connect(dst)
+	if err != nil {
+		return nil, err
+	}
+	pathIDs = append(pathIDs, id)
+
+	modules, err := commonIBCModules(chains[0], chains[1])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, module := range modules {
+		id, err := src.Connect(dst,
+			relayer.SourcePort(module.PortID),
+			relayer.SourceVersion(module.Version),
+			relayer.TargetPort(module.PortID),
+			relayer.TargetVersion(module.Version),
+		)
+		if err != nil {
+			return nil, err
+		}
+		pathIDs = append(pathIDs, id)
+	}
+
+	return pathIDs, nil
+}
+
+// commonIBCModules returns the IBC modules scaffolded in both a and b.
+func commonIBCModules(a, b *playgroundChain) ([]chain.IBCModule, error) {
+	modulesA, err := a.IBCModules()
+	if err != nil {
+		return nil, err
+	}
+
+	modulesB, err := b.IBCModules()
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []chain.IBCModule
+
+	for _, ma := range modulesA {
+		for _, mb := range modulesB {
+			if ma == mb {
+				modules = append(modules, ma)
+			}
+		}
+	}
+
+	return modules, nil
+}
+
+func printPlaygroundSummary(
+	ctx context.Context,
+	session cliui.Session,
+	r relayer.Relayer,
+	chains []*playgroundChain,
+	pathIDs []string,
+) error {
+	var chainEntries [][]string
+
+	for _, c := range chains {
+		api, _ := xurl.HTTP(c.config.Host.API)
+
+		chainEntries = append(chainEntries, []string{
+			c.id,
+			c.Name(),
+			c.rpcAddress(),
+			api,
+			c.config.Host.GRPC,
+			c.faucetAddress(),
+		})
+	}
+
+	var pathEntries [][]string
+
+	for _, id := range pathIDs {
+		path, err := r.GetPath(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		pathEntries = append(pathEntries, []string{
+			path.ID,
+			fmt.Sprintf("%s (port: %s, channel: %s)", path.Src.ChainID, path.Src.PortID, path.Src.ChannelID),
+			fmt.Sprintf("%s (port: %s, channel: %s)", path.Dst.ChainID, path.Dst.PortID, path.Dst.ChannelID),
+		})
+	}
+
+	if err := printSection(session, "Chains"); err != nil {
+		return err
+	}
+	if err := session.PrintTable(playgroundChainsHeader, chainEntries...); err != nil {
+		return err
+	}
+
+	if err := session.Println(); err != nil {
+		return err
+	}
+
+	if err := printSection(session, "Paths"); err != nil {
+		return err
+	}
+	if err := session.PrintTable(playgroundPathsHeader, pathEntries...); err != nil {
+		return err
+	}
+
+	return session.Printf("\n%s Relaying packets between the chains, press Ctrl+C to stop\n", icons.OK)
+}
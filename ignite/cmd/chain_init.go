@@ -1,8 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
@@ -10,6 +8,11 @@ import (
 	"github.com/ignite-hq/cli/ignite/services/chain"
 )
 
+// chainInitResult is the result of the initialization printed in JSON output.
+type chainInitResult struct {
+	Home string `json:"home"`
+}
+
 func NewChainInit() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "init",
@@ -54,7 +57,10 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	fmt.Printf("🗃  Initialized. Checkout your chain's home (data) directory: %s\n", colors.Info(home))
+	session := newSession(cmd)
+	defer session.Cleanup()
 
-	return nil
+	return session.PrintResult(chainInitResult{Home: home}, func() error {
+		return session.Printf("🗃  Initialized. Checkout your chain's home (data) directory: %s\n", colors.Info(home))
+	})
 }
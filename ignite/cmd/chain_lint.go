@@ -0,0 +1,19 @@
+package ignitecmd
+
+import "github.com/spf13/cobra"
+
+// NewChainLint returns a command that groups sub commands related to linting
+// the source code of a blockchain.
+func NewChainLint() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "lint [command]",
+		Short: "Check the source code of your blockchain for common mistakes",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	flagSetPath(c)
+
+	c.AddCommand(NewChainLintProto())
+
+	return c
+}
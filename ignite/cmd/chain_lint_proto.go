@@ -0,0 +1,94 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosprotolint"
+)
+
+// NewChainLintProto returns a command that checks proto files for Cosmos SDK conventions.
+func NewChainLintProto() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "proto",
+		Short: "Check proto files for Cosmos SDK conventions",
+		Long: `Check the proto files of your blockchain for Cosmos SDK conventions:
+
+- RPCs of Msg services use Msg prefixed request types named after them
+- every Msg has a response type
+- every Msg has a signer field, set with the cosmos.msg.v1.signer option or named creator
+- RPCs of Query services use Query prefixed request and response types named after them
+- go_package options point to packages of the app's Go module
+- proto packages don't import each other in cycles
+
+The command exits with a non-zero status when issues are found.`,
+		Args: cobra.NoArgs,
+		RunE: chainLintProtoHandler,
+	}
+
+	return c
+}
+
+func chainLintProtoHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Linting proto files...")
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	appPath, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	issues, err := cosmosprotolint.Lint(cmd.Context(), appPath, conf.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	// show paths relative to the app.
+	for i, issue := range issues {
+		if path, err := filepath.Rel(appPath, issue.Path); err == nil {
+			issues[i].Path = path
+		}
+	}
+
+	session.StopSpinner()
+
+	if issues == nil {
+		issues = []cosmosprotolint.Issue{}
+	}
+
+	if err := session.PrintResult(issues, func() error {
+		if len(issues) == 0 {
+			return session.Printf("%s No issues found in proto files\n", icons.OK)
+		}
+
+		for _, issue := range issues {
+			if err := session.Printf("%s %s\n", icons.NotOK, issue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("%d issue(s) found in proto files", len(issues))
+	}
+
+	return nil
+}
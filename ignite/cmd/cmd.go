@@ -16,6 +16,7 @@ import (
 	"github.com/ignite-hq/cli/ignite/chainconfig"
 	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosver"
 	"github.com/ignite-hq/cli/ignite/pkg/gitpod"
@@ -27,14 +28,20 @@ import (
 )
 
 const (
-	flagPath          = "path"
-	flagHome          = "home"
-	flagProto3rdParty = "proto-all-modules"
-	flagYes           = "yes"
-	flagClearCache    = "clear-cache"
+	flagPath           = "path"
+	flagHome           = "home"
+	flagProto3rdParty  = "proto-all-modules"
+	flagYes            = "yes"
+	flagClearCache     = "clear-cache"
+	flagAnswers        = "answers"
+	flagNonInteractive = "non-interactive"
+	flagOutputFormat   = "output-format"
 
 	checkVersionTimeout = time.Millisecond * 600
 	cacheFileName       = "ignite_cache.db"
+
+	// envOutput sets the default output format of the commands.
+	envOutput = "IGNITE_OUTPUT"
 )
 
 // New creates a new root command for `Ignite CLI` with its sub commands.
@@ -54,9 +61,20 @@ ignite scaffold chain github.com/username/mars`,
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cliui.ParseOutputFormat(string(getOutputFormat(cmd)))
+			if err != nil {
+				return err
+			}
+
+			// fail fast on an invalid answers file instead of when the first question is asked.
+			if _, err := newQuiz(cmd); err != nil {
+				return err
+			}
+
 			// Check for new versions only when shell completion scripts are not being
-			// generated to avoid invalid output to stdout when a new version is available
-			if cmd.Use != "completions" {
+			// generated and the output is not JSON to avoid invalid output to stdout
+			// when a new version is available
+			if cmd.Use != "completions" && format != cliui.OutputJSON {
 				checkNewVersion(cmd.Context())
 			}
 
@@ -64,6 +82,22 @@ ignite scaffold chain github.com/username/mars`,
 		},
 	}
 
+	defaultOutput := os.Getenv(envOutput)
+	if defaultOutput == "" {
+		defaultOutput = string(cliui.OutputText)
+	}
+	c.PersistentFlags().String(
+		flagOutputFormat,
+		defaultOutput,
+		fmt.Sprintf("Output format %v, json prints events, results and errors as a JSON object per line (env %s)", cliui.OutputFormats, envOutput),
+	)
+	c.PersistentFlags().Bool(
+		flagNonInteractive,
+		false,
+		"Never prompt, use the answers file and the default answers and fail on missing inputs",
+	)
+	c.PersistentFlags().String(flagAnswers, "", "YAML file with the answers of the questions by their keys")
+
 	c.AddCommand(NewScaffold())
 	c.AddCommand(NewChain())
 	c.AddCommand(NewGenerate())
@@ -83,6 +117,10 @@ func logLevel(cmd *cobra.Command) chain.LogLvl {
 	if verbose {
 		return chain.LogVerbose
 	}
+	// logs are text, only results are printed in JSON output.
+	if getOutputFormat(cmd) == cliui.OutputJSON {
+		return chain.LogSilent
+	}
 	return chain.LogRegular
 }
 
@@ -203,6 +241,45 @@ func sourceModificationToString(sm xgenny.SourceModification) (string, error) {
 	return "\n" + strings.Join(files, "\n"), nil
 }
 
+// sourceModificationResult is the result of a scaffolding printed in JSON output.
+type sourceModificationResult struct {
+	Created  []string `json:"created"`
+	Modified []string `json:"modified"`
+}
+
+// printSourceModification prints the files created and modified by sm, followed by message.
+func printSourceModification(session cliui.Session, sm xgenny.SourceModification, message string) error {
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	result := sourceModificationResult{Created: []string{}, Modified: []string{}}
+	for _, created := range sm.CreatedFiles() {
+		path, err := relativePath(created)
+		if err != nil {
+			return err
+		}
+		result.Created = append(result.Created, path)
+	}
+	for _, modified := range sm.ModifiedFiles() {
+		path, err := relativePath(modified)
+		if err != nil {
+			return err
+		}
+		result.Modified = append(result.Modified, path)
+	}
+	sort.Strings(result.Created)
+	sort.Strings(result.Modified)
+
+	return session.PrintResult(result, func() error {
+		if err := session.Println(modificationsStr); err != nil {
+			return err
+		}
+		return session.Print(message)
+	})
+}
+
 func deprecated() []*cobra.Command {
 	return []*cobra.Command{
 		{
@@ -282,6 +359,56 @@ func printSection(session cliui.Session, title string) error {
 	return session.Printf("------\n%s\n------\n\n", title)
 }
 
+// getOutputFormat returns the output format set by the global output format flag.
+func getOutputFormat(cmd *cobra.Command) cliui.OutputFormat {
+	format, _ := cmd.Root().PersistentFlags().GetString(flagOutputFormat)
+	return cliui.OutputFormat(format)
+}
+
+// NewErrorSession creates a session to print the error returned by the execution of
+// cmd in the output format set for the command.
+func NewErrorSession(cmd *cobra.Command) cliui.Session {
+	return cliui.New(cliui.WithOutputFormat(getOutputFormat(cmd)))
+}
+
+// getNonInteractive checks if the global non-interactive flag is set.
+func getNonInteractive(cmd *cobra.Command) bool {
+	nonInteractive, _ := cmd.Root().PersistentFlags().GetBool(flagNonInteractive)
+	return nonInteractive
+}
+
+// newQuiz creates a quiz that answers questions with the answers file and prompts
+// only in interactive mode.
+func newQuiz(cmd *cobra.Command) (cliquiz.Quiz, error) {
+	var options []cliquiz.QuizOption
+
+	if path, _ := cmd.Root().PersistentFlags().GetString(flagAnswers); path != "" {
+		answers, err := cliquiz.LoadAnswers(path)
+		if err != nil {
+			return cliquiz.Quiz{}, err
+		}
+		options = append(options, cliquiz.WithAnswers(answers))
+	}
+	if getNonInteractive(cmd) {
+		options = append(options, cliquiz.WithNonInteractive())
+	}
+
+	return cliquiz.NewQuiz(options...), nil
+}
+
+// newSession creates a new session that prints in the output format of cmd and
+// answers questions with its quiz.
+func newSession(cmd *cobra.Command, options ...cliui.Option) cliui.Session {
+	// the answers file is validated before running the commands.
+	quiz, _ := newQuiz(cmd)
+
+	return cliui.New(append(
+		options,
+		cliui.WithOutputFormat(getOutputFormat(cmd)),
+		cliui.WithQuiz(quiz),
+	)...)
+}
+
 func newCache(cmd *cobra.Command) (cache.Storage, error) {
 	cacheRootDir, err := chainconfig.ConfigDirPath()
 	if err != nil {
@@ -22,6 +22,8 @@ Produced source code can be regenerated by running a command again and is not me
 	c.AddCommand(addGitChangesVerifier(NewGenerateVuex()))
 	c.AddCommand(addGitChangesVerifier(NewGenerateDart()))
 	c.AddCommand(addGitChangesVerifier(NewGenerateOpenAPI()))
+	c.AddCommand(addGitChangesVerifier(NewGenerateDocs()))
+	c.AddCommand(NewGenerateCheckBreaking())
 
 	return c
 }
@@ -0,0 +1,130 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite-hq/cli/ignite/pkg/xgit"
+)
+
+const flagAgainst = "against"
+
+// breakingChangeResult is a breaking change printed in JSON output.
+type breakingChangeResult struct {
+	Path        string `json:"path"`
+	Description string `json:"description"`
+}
+
+// NewGenerateCheckBreaking returns a command that checks proto files for breaking changes.
+func NewGenerateCheckBreaking() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "check-breaking",
+		Short: "Check proto files for changes that break wire compatibility",
+		Long: `Compare the app's proto files with the ones from a git revision and report
+the changes that break wire compatibility for clients: removed messages, removed fields,
+fields with changed numbers or types, and removed or renamed RPCs.
+
+The command exits with a non-zero status when breaking changes are found, so it can
+be used in CI before tagging a release.
+
+Sample usages:
+	- ignite generate check-breaking --against main
+	- ignite generate check-breaking --against v0.1.0`,
+		Args: cobra.NoArgs,
+		RunE: generateCheckBreakingHandler,
+	}
+
+	c.Flags().String(flagAgainst, "", "git branch, tag or commit to compare the proto files against")
+	c.MarkFlagRequired(flagAgainst)
+
+	return c
+}
+
+func generateCheckBreakingHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Checking proto files...")
+
+	against, _ := cmd.Flags().GetString(flagAgainst)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	appPath, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	againstPath, err := os.MkdirTemp("", "ignite-check-breaking")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(againstPath)
+
+	if err := xgit.ExportRevision(appPath, against, againstPath); err != nil {
+		return fmt.Errorf("cannot checkout %s: %w", against, err)
+	}
+
+	before, err := protoanalysis.Parse(cmd.Context(), nil, filepath.Join(againstPath, conf.Build.Proto.Path))
+	if err != nil {
+		return err
+	}
+
+	after, err := protoanalysis.Parse(cmd.Context(), nil, filepath.Join(appPath, conf.Build.Proto.Path))
+	if err != nil {
+		return err
+	}
+
+	changes := protoanalysis.FindBreakingChanges(before, after)
+
+	session.StopSpinner()
+
+	results := make([]breakingChangeResult, 0, len(changes))
+	for _, change := range changes {
+		// report paths relative to the app so they're the same for both revisions.
+		path := change.Path
+		for _, root := range []string{againstPath, appPath} {
+			if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+				path = rel
+				break
+			}
+		}
+
+		results = append(results, breakingChangeResult{Path: path, Description: change.Description})
+	}
+
+	if err := session.PrintResult(results, func() error {
+		if len(results) == 0 {
+			return session.Printf("%s No breaking changes found against %s\n", icons.OK, against)
+		}
+
+		for _, result := range results {
+			if err := session.Printf("%s %s: %s\n", icons.NotOK, result.Path, result.Description); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d breaking change(s) found against %s", len(changes), against)
+}
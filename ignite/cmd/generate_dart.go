@@ -1,11 +1,8 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/services/chain"
 )
 
@@ -19,8 +16,10 @@ func NewGenerateDart() *cobra.Command {
 }
 
 func generateDartHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Generating...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Generating...")
 
 	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
 	if err != nil {
@@ -36,8 +35,7 @@ func generateDartHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
-	fmt.Println("⛏️  Generated Dart client.")
+	session.StopSpinner()
 
-	return nil
+	return session.Println("⛏️  Generated Dart client.")
 }
@@ -0,0 +1,82 @@
+package ignitecmd
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosdocs"
+)
+
+const defaultModuleDocsPath = "docs/modules"
+
+// docsResult is the result of the docs generation printed in JSON output.
+type docsResult struct {
+	Path  string   `json:"path"`
+	Files []string `json:"files"`
+}
+
+// NewGenerateDocs returns a command that generates a Markdown reference for the chain's modules.
+func NewGenerateDocs() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "docs",
+		Short: "Generate a Markdown API reference for your chain's modules",
+		Long: `Generate a Markdown API reference for each module of your chain.
+
+The reference covers messages, queries with their REST routes, events, params and
+genesis fields. Descriptions come from the leading comments in your proto files and
+CLI usages come from the scaffolded CLI commands of the modules.`,
+		Args: cobra.NoArgs,
+		RunE: generateDocsHandler,
+	}
+
+	c.Flags().String(flagOut, defaultModuleDocsPath, "Path to output the Markdown files relative to the app")
+
+	return c
+}
+
+func generateDocsHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Generating...")
+
+	out, _ := cmd.Flags().GetString(flagOut)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	binaryName, err := c.Binary()
+	if err != nil {
+		return err
+	}
+
+	appPath, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	paths, err := cosmosdocs.Generate(
+		cmd.Context(),
+		appPath,
+		conf.Build.Proto.Path,
+		binaryName,
+		filepath.Join(appPath, out),
+	)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.PrintResult(docsResult{Path: out, Files: paths}, func() error {
+		return session.Printf("⛏️  Generated docs for %d module(s) in %s.\n", len(paths), out)
+	})
+}
@@ -1,11 +1,8 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/services/chain"
 )
 
@@ -18,8 +15,10 @@ func NewGenerateOpenAPI() *cobra.Command {
 }
 
 func generateOpenAPIHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Generating...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Generating...")
 
 	c, err := newChainWithHomeFlags(cmd)
 	if err != nil {
@@ -35,8 +34,7 @@ func generateOpenAPIHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
-	fmt.Println("⛏️  Generated OpenAPI spec.")
+	session.StopSpinner()
 
-	return nil
+	return session.Println("⛏️  Generated OpenAPI spec.")
 }
@@ -1,11 +1,8 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/services/chain"
 )
 
@@ -20,8 +17,10 @@ func NewGenerateVuex() *cobra.Command {
 }
 
 func generateVuexHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Generating...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Generating...")
 
 	c, err := newChainWithHomeFlags(cmd, chain.EnableThirdPartyModuleCodegen())
 	if err != nil {
@@ -37,8 +36,7 @@ func generateVuexHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
-	fmt.Println("⛏️  Generated vuex stores.")
+	session.StopSpinner()
 
-	return nil
+	return session.Println("⛏️  Generated vuex stores.")
 }
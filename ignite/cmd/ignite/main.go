@@ -3,7 +3,6 @@ package main
 import (
 	"context"
 	"errors"
-	"fmt"
 	"os"
 
 	ignitecmd "github.com/ignite-hq/cli/ignite/cmd"
@@ -14,10 +13,15 @@ import (
 func main() {
 	ctx := clictx.From(context.Background())
 
-	err := ignitecmd.New().ExecuteContext(ctx)
+	cmd := ignitecmd.New()
+	err := cmd.ExecuteContext(ctx)
+
+	// errors are printed as JSON records with --output-format json.
+	session := ignitecmd.NewErrorSession(cmd)
+	defer session.Cleanup()
 
 	if ctx.Err() == context.Canceled || err == context.Canceled {
-		fmt.Println("aborted")
+		_ = session.PrintError(errors.New("aborted"))
 		return
 	}
 
@@ -25,11 +29,11 @@ func main() {
 		var validationErr validation.Error
 
 		if errors.As(err, &validationErr) {
-			fmt.Println(validationErr.ValidationInfo())
-		} else {
-			fmt.Println(err)
+			err = errors.New(validationErr.ValidationInfo())
 		}
+		_ = session.PrintError(err)
 
+		session.Cleanup()
 		os.Exit(1)
 	}
 }
@@ -60,6 +60,7 @@ func NewNetwork() *cobra.Command {
 		NewNetworkRequest(),
 		NewNetworkReward(),
 		NewNetworkClient(),
+		NewNetworkDevnet(),
 	)
 
 	return c
@@ -109,6 +110,29 @@ func newNetworkBuilder(cmd *cobra.Command, options ...NetworkBuilderOption) (Net
 	return n, nil
 }
 
+// newLocalNetworkBuilder creates a network builder that doesn't connect to SPN, it builds
+// the chains of launches with the local account registry only and cannot create a Network.
+func newLocalNetworkBuilder(cmd *cobra.Command, options ...NetworkBuilderOption) (NetworkBuilder, error) {
+	keyringBackend := getKeyringBackend(cmd)
+	if gitpod.IsOnGitpod() {
+		keyringBackend = cosmosaccount.KeyringTest
+	}
+
+	ar, err := cosmosaccount.NewStandalone(cosmosaccount.WithKeyringBackend(keyringBackend))
+	if err != nil {
+		return NetworkBuilder{}, err
+	}
+
+	n := NetworkBuilder{
+		AccountRegistry: ar,
+		cmd:             cmd,
+	}
+	for _, apply := range options {
+		apply(&n)
+	}
+	return n, nil
+}
+
 func (n NetworkBuilder) Chain(source networkchain.SourceOption, options ...networkchain.Option) (*networkchain.Chain, error) {
 	if home := getHome(n.cmd); home != "" {
 		options = append(options, networkchain.WithHome(home))
@@ -120,6 +144,10 @@ func (n NetworkBuilder) Chain(source networkchain.SourceOption, options ...netwo
 }
 
 func (n NetworkBuilder) Network(options ...network.Option) (network.Network, error) {
+	if cosmos == nil {
+		return network.Network{}, errors.New("the network builder is not connected to SPN")
+	}
+
 	var (
 		err     error
 		from    = getFrom(n.cmd)
@@ -148,6 +176,12 @@ func getNetworkCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
 	} else if nightly {
 		spnNodeAddress = spnNodeAddressNightly
 		spnFaucetAddress = spnFaucetAddressNightly
+	} else if devnet, ok := runningDevnet(); ok && !cmd.Flags().Changed(flagSPNNodeAddress) {
+		// the devnet served by "network devnet" is used by default while it runs.
+		spnNodeAddress = devnet.NodeAddress
+		if !cmd.Flags().Changed(flagSPNFaucetAddress) {
+			spnFaucetAddress = devnet.FaucetAddress
+		}
 	}
 
 	cosmosOptions := []cosmosclient.Option{
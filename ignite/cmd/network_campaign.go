@@ -17,6 +17,7 @@ func NewNetworkCampaign() *cobra.Command {
 		NewNetworkCampaignShow(),
 		NewNetworkCampaignUpdate(),
 		NewNetworkCampaignAccount(),
+		NewNetworkCampaignVoucher(),
 	)
 	return c
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
 )
 
 var (
@@ -20,6 +21,7 @@ func NewNetworkCampaignAccount() *cobra.Command {
 	}
 	c.AddCommand(
 		newNetworkCampaignAccountList(),
+		newNetworkCampaignAccountShow(),
 	)
 	return c
 }
@@ -35,7 +37,7 @@ func newNetworkCampaignAccountList() *cobra.Command {
 }
 
 func newNetworkCampaignAccountListHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, campaignID, err := networkChainLaunch(cmd, args, session)
@@ -72,3 +74,55 @@ func newNetworkCampaignAccountListHandler(cmd *cobra.Command, args []string) err
 
 	return nil
 }
+
+func newNetworkCampaignAccountShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show [campaign-id] [address]",
+		Short: "Show the mainnet shares and the vouchers of an account in the campaign",
+		Long: `Show the mainnet shares and the vouchers of an account in the campaign, with the coins of the
+mainnet account from the total supply of the campaign. The account of --from is used when no
+address is provided.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: networkCampaignAccountShowHandler,
+	}
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+func networkCampaignAccountShowHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	nb, campaignID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	var address string
+	if len(args) > 1 {
+		address = args[1]
+	}
+
+	acc, err := n.CampaignAccount(cmd.Context(), campaignID, address)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return printCampaignAccount(session, acc)
+}
+
+// printCampaignAccount prints the shares and the vouchers of an account in a campaign.
+func printCampaignAccount(session cliui.Session, acc networktypes.CampaignAccount) error {
+	return session.PrintResult(acc, func() error {
+		return session.PrintTable(
+			[]string{"Account", "Shares", "Vouchers", "Mainnet Coins"},
+			[]string{acc.Address, acc.Shares.String(), acc.Vouchers.String(), acc.Coins.String()},
+		)
+	})
+}
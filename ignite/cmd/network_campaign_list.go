@@ -29,7 +29,7 @@ func NewNetworkCampaignList() *cobra.Command {
 }
 
 func networkCampaignListHandler(cmd *cobra.Command, _ []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -4,7 +4,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
 )
 
@@ -28,7 +27,7 @@ func NewNetworkCampaignPublish() *cobra.Command {
 }
 
 func networkCampaignPublishHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
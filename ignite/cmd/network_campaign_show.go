@@ -3,7 +3,6 @@ package ignitecmd
 import (
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/yaml"
 	"github.com/ignite-hq/cli/ignite/services/network"
 )
@@ -20,7 +19,7 @@ func NewNetworkCampaignShow() *cobra.Command {
 }
 
 func networkCampaignShowHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	// parse campaign ID
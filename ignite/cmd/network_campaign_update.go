@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/yaml"
 	"github.com/ignite-hq/cli/ignite/services/network"
 )
@@ -34,7 +33,7 @@ func NewNetworkCampaignUpdate() *cobra.Command {
 }
 
 func networkCampaignUpdateHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	var (
@@ -0,0 +1,216 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/services/network"
+)
+
+const (
+	flagMainnetAccount = "account"
+)
+
+// NewNetworkCampaignVoucher creates a new campaign voucher command that holds some other
+// sub commands related to the vouchers and shares of a campaign.
+func NewNetworkCampaignVoucher() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "voucher",
+		Short: "Handle campaign vouchers",
+		Long: `Handle the vouchers of a campaign.
+
+Vouchers are the tokens that represent the shares of the mainnet supply of a campaign, the coordinator
+mints vouchers from shares and the owners of vouchers redeem them into the shares of a mainnet account.
+
+Shares are provided as amounts or percentages of the total shares (1000foo,12.5%bar), vouchers are
+provided as amounts with or without the voucher prefix of the campaign (1000foo,500v/1/bar).`,
+	}
+	c.AddCommand(
+		newNetworkCampaignVoucherMint(),
+		newNetworkCampaignVoucherBurn(),
+		newNetworkCampaignVoucherRedeem(),
+		newNetworkCampaignVoucherUnredeem(),
+	)
+	return c
+}
+
+func newNetworkCampaignVoucherMint() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "mint [campaign-id] [shares]",
+		Short: "Mint vouchers from shares of the campaign",
+		Args:  cobra.ExactArgs(2),
+		RunE:  networkCampaignVoucherMintHandler,
+	}
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+func networkCampaignVoucherMintHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	nb, campaignID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	totalShares, err := n.TotalShares(cmd.Context())
+	if err != nil {
+		return err
+	}
+	shares, err := network.ParseShares(args[1], totalShares)
+	if err != nil {
+		return err
+	}
+
+	if err := n.MintVouchers(cmd.Context(), campaignID, shares); err != nil {
+		return err
+	}
+
+	campaign, err := n.Campaign(cmd.Context(), campaignID)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return session.Printf("%s Allocated shares of the campaign: %s\n", icons.Info, campaign.AllocatedShares)
+}
+
+func newNetworkCampaignVoucherBurn() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "burn [campaign-id] [vouchers]",
+		Short: "Burn vouchers and deallocate their shares of the campaign",
+		Args:  cobra.ExactArgs(2),
+		RunE:  networkCampaignVoucherBurnHandler,
+	}
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+func networkCampaignVoucherBurnHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	nb, campaignID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	vouchers, err := network.ParseVouchers(args[1], campaignID)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	if err := n.BurnVouchers(cmd.Context(), campaignID, vouchers); err != nil {
+		return err
+	}
+
+	campaign, err := n.Campaign(cmd.Context(), campaignID)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return session.Printf("%s Allocated shares of the campaign: %s\n", icons.Info, campaign.AllocatedShares)
+}
+
+func newNetworkCampaignVoucherRedeem() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "redeem [campaign-id] [vouchers]",
+		Short: "Redeem vouchers into the shares of a mainnet account",
+		Args:  cobra.ExactArgs(2),
+		RunE:  networkCampaignVoucherRedeemHandler,
+	}
+	c.Flags().String(flagMainnetAccount, "", "Address of the mainnet account that receives the shares (default is the sender)")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+func networkCampaignVoucherRedeemHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	account, _ := cmd.Flags().GetString(flagMainnetAccount)
+
+	nb, campaignID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	vouchers, err := network.ParseVouchers(args[1], campaignID)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	if err := n.RedeemVouchers(cmd.Context(), campaignID, account, vouchers); err != nil {
+		return err
+	}
+
+	acc, err := n.CampaignAccount(cmd.Context(), campaignID, account)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return printCampaignAccount(session, acc)
+}
+
+func newNetworkCampaignVoucherUnredeem() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "unredeem [campaign-id] [shares]",
+		Short: "Convert shares of the mainnet account back into vouchers",
+		Args:  cobra.ExactArgs(2),
+		RunE:  networkCampaignVoucherUnredeemHandler,
+	}
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+func networkCampaignVoucherUnredeemHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	nb, campaignID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	totalShares, err := n.TotalShares(cmd.Context())
+	if err != nil {
+		return err
+	}
+	shares, err := network.ParseShares(args[1], totalShares)
+	if err != nil {
+		return err
+	}
+
+	if err := n.UnredeemVouchers(cmd.Context(), campaignID, shares); err != nil {
+		return err
+	}
+
+	acc, err := n.CampaignAccount(cmd.Context(), campaignID, "")
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	return printCampaignAccount(session, acc)
+}
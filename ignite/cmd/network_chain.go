@@ -19,9 +19,12 @@ func NewNetworkChain() *cobra.Command {
 		NewNetworkChainInstall(),
 		NewNetworkChainJoin(),
 		NewNetworkChainPrepare(),
+		NewNetworkChainExport(),
 		NewNetworkChainShow(),
 		NewNetworkChainLaunch(),
 		NewNetworkChainRevertLaunch(),
+		NewNetworkChainMonitor(),
+		NewNetworkChainVerifyBinary(),
 	)
 
 	return c
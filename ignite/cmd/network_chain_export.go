@@ -0,0 +1,125 @@
+package ignitecmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/services/network"
+	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+)
+
+// NewNetworkChainExport creates a new chain export command to bundle the information
+// to prepare a chain launch offline.
+func NewNetworkChainExport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export [launch-id]",
+		Short: "Export the information to prepare the chain for launch into a bundle",
+		Long: `Export the source, the initial genesis, the approved requests, the final genesis and the
+peers of a chain launch into a gzipped tarball.
+
+The bundle can be shared with the validators to prepare the chain for launch without SPN with
+"ignite network chain prepare --from-bundle", the hash of the genesis they prepare is checked
+against the hash of the genesis of the bundle.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainExportHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().StringP(flagOut, "o", "launch.tar.gz", "Path to output the launch bundle")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainExportHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	out, _ := cmd.Flags().GetString(flagOut)
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	bundle, err := n.LaunchBundle(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	// prepare the chain in a temp dir to generate the final genesis
+	tmpHome, err := os.MkdirTemp("", "*-spn")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpHome)
+
+	c, err := nb.Chain(
+		networkchain.SourceLaunch(bundle.ChainLaunch),
+		networkchain.WithHome(tmpHome),
+		networkchain.WithInitialGenesis(bundle.InitialGenesis),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Prepare(
+		cmd.Context(),
+		cacheStorage,
+		bundle.GenesisInformation,
+		bundle.RewardsInfo,
+		bundle.SPNChainID,
+		bundle.LastBlockHeight,
+		bundle.UnbondingTime,
+	); err != nil {
+		return err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	if bundle.Genesis, err = os.ReadFile(genesisPath); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(out), 0744); err != nil {
+		return err
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := network.WriteBundle(f, bundle); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	result := struct {
+		BundlePath  string `json:"bundle_path"`
+		GenesisHash string `json:"genesis_hash"`
+	}{
+		BundlePath:  out,
+		GenesisHash: bundle.GenesisHash(),
+	}
+
+	return session.PrintResult(result, func() error {
+		return session.Printf("%s Launch bundle exported: %s\n%s Genesis hash: %s\n", icons.OK, out, icons.Info, result.GenesisHash)
+	})
+}
@@ -3,6 +3,7 @@ package ignitecmd
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
@@ -16,14 +17,19 @@ import (
 )
 
 const (
-	flagValidatorAccount         = "validator-account"
-	flagValidatorWebsite         = "validator-website"
-	flagValidatorDetails         = "validator-details"
-	flagValidatorSecurityContact = "validator-security-contact"
-	flagValidatorMoniker         = "validator-moniker"
-	flagValidatorIdentity        = "validator-identity"
-	flagValidatorSelfDelegation  = "validator-self-delegation"
-	flagValidatorGasPrice        = "validator-gas-price"
+	flagValidatorAccount                 = "validator-account"
+	flagValidatorWebsite                 = "validator-website"
+	flagValidatorDetails                 = "validator-details"
+	flagValidatorSecurityContact         = "validator-security-contact"
+	flagValidatorMoniker                 = "validator-moniker"
+	flagValidatorIdentity                = "validator-identity"
+	flagValidatorSelfDelegation          = "validator-self-delegation"
+	flagValidatorGasPrice                = "validator-gas-price"
+	flagValidatorStakingAmount           = "validator-staking-amount"
+	flagValidatorCommissionRate          = "validator-commission-rate"
+	flagValidatorCommissionMaxRate       = "validator-commission-max-rate"
+	flagValidatorCommissionMaxChangeRate = "validator-commission-max-change-rate"
+	flagValidatorFile                    = "validator-file"
 )
 
 // NewNetworkChainInit returns a new command to initialize a chain from a published chain ID
@@ -31,8 +37,28 @@ func NewNetworkChainInit() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "init [launch-id]",
 		Short: "Initialize a chain from a published chain ID",
-		Args:  cobra.ExactArgs(1),
-		RunE:  networkChainInitHandler,
+		Long: `Initialize a chain from a published chain ID and generate the gentx of its validator.
+
+The validator can be described in a YAML file with --validator-file, the flags override the values of the file:
+
+  validator:
+    moniker: alice
+    website: https://example.com
+    identity: ""
+    details: ""
+    security_contact: alice@example.com
+    staking_amount: 95000000stake
+    self_delegation: "1"
+    gas_price: 0stake
+    commission_rate: "0.10"
+    commission_max_rate: "0.20"
+    commission_max_change_rate: "0.01"
+
+The staking amount and the commission rates that are not set are prompted. The validator is checked before
+the gentx is generated: the max rate must be greater than or equal to the rate and the self-delegation can't
+exceed the staking amount.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainInitHandler,
 	}
 
 	flagSetClearCache(c)
@@ -44,6 +70,11 @@ func NewNetworkChainInit() *cobra.Command {
 	c.Flags().String(flagValidatorIdentity, "", "Validator identity signature (ex. UPort or Keybase)")
 	c.Flags().String(flagValidatorSelfDelegation, "", "Validator minimum self delegation")
 	c.Flags().String(flagValidatorGasPrice, "", "Validator gas price")
+	c.Flags().String(flagValidatorStakingAmount, "", "Amount of coins staked by the validator")
+	c.Flags().String(flagValidatorCommissionRate, "", "Validator commission rate")
+	c.Flags().String(flagValidatorCommissionMaxRate, "", "Validator commission max rate")
+	c.Flags().String(flagValidatorCommissionMaxChangeRate, "", "Validator commission max change rate")
+	c.Flags().String(flagValidatorFile, "", "Path to a YAML file with the validator information")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -52,7 +83,7 @@ func NewNetworkChainInit() *cobra.Command {
 }
 
 func networkChainInitHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -72,6 +103,12 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// read the validator information before initializing the chain to fail early.
+	v, err := validatorFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
 	// if a chain has already been initialized with this launch ID, we ask for confirmation
 	// before erasing the directory.
 	chainHome, exist, err := networkchain.IsChainHomeExist(launchID)
@@ -85,6 +122,9 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 			chainHome,
 		)
 		if err := session.AskConfirm(question); err != nil {
+			if errors.Is(err, cliquiz.ErrNonInteractive) {
+				return errors.Wrapf(err, "use --%s to confirm", flagYes)
+			}
 			return session.PrintSaidNo()
 		}
 	}
@@ -124,8 +164,7 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	// ask validator information.
-	v, err := askValidatorInfo(cmd, session, genesis.StakeDenom)
-	if err != nil {
+	if v, err = askValidatorInfo(session, v, genesis.StakeDenom); err != nil {
 		return err
 	}
 	session.StartSpinner("Generating your Gentx")
@@ -140,53 +179,67 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 	return session.Printf("%s Gentx generated: %s\n", icons.Bullet, gentxPath)
 }
 
-// askValidatorInfo prompts to the user questions to query validator information
-func askValidatorInfo(cmd *cobra.Command, session cliui.Session, stakeDenom string) (chain.Validator, error) {
-	var (
-		account, _         = cmd.Flags().GetString(flagValidatorAccount)
-		website, _         = cmd.Flags().GetString(flagValidatorWebsite)
-		details, _         = cmd.Flags().GetString(flagValidatorDetails)
-		securityContact, _ = cmd.Flags().GetString(flagValidatorSecurityContact)
-		moniker, _         = cmd.Flags().GetString(flagValidatorMoniker)
-		identity, _        = cmd.Flags().GetString(flagValidatorIdentity)
-		selfDelegation, _  = cmd.Flags().GetString(flagValidatorSelfDelegation)
-		gasPrice, _        = cmd.Flags().GetString(flagValidatorGasPrice)
-	)
-	if gasPrice == "" {
-		gasPrice = "0" + stakeDenom
-	}
-	v := chain.Validator{
-		Name:              account,
-		Website:           website,
-		Details:           details,
-		Moniker:           moniker,
-		Identity:          identity,
-		SecurityContact:   securityContact,
-		MinSelfDelegation: selfDelegation,
-		GasPrices:         gasPrice,
-	}
-
-	questions := append([]cliquiz.Question{},
-		cliquiz.NewQuestion("Staking amount",
-			&v.StakingAmount,
-			cliquiz.DefaultAnswer("95000000stake"),
-			cliquiz.Required(),
-		),
-		cliquiz.NewQuestion("Commission rate",
-			&v.CommissionRate,
-			cliquiz.DefaultAnswer("0.10"),
-			cliquiz.Required(),
-		),
-		cliquiz.NewQuestion("Commission max rate",
-			&v.CommissionMaxRate,
-			cliquiz.DefaultAnswer("0.20"),
-			cliquiz.Required(),
-		),
-		cliquiz.NewQuestion("Commission max change rate",
-			&v.CommissionMaxChangeRate,
-			cliquiz.DefaultAnswer("0.01"),
+// validatorFromFlags returns the validator information of the validator file overridden by the flags.
+func validatorFromFlags(cmd *cobra.Command) (v chain.Validator, err error) {
+	if path, _ := cmd.Flags().GetString(flagValidatorFile); path != "" {
+		if v, err = networkchain.LoadValidatorFile(path); err != nil {
+			return v, err
+		}
+	}
+	v.Name, _ = cmd.Flags().GetString(flagValidatorAccount)
+
+	for flag, value := range map[string]*string{
+		flagValidatorWebsite:                 &v.Website,
+		flagValidatorDetails:                 &v.Details,
+		flagValidatorSecurityContact:         &v.SecurityContact,
+		flagValidatorMoniker:                 &v.Moniker,
+		flagValidatorIdentity:                &v.Identity,
+		flagValidatorSelfDelegation:          &v.MinSelfDelegation,
+		flagValidatorGasPrice:                &v.GasPrices,
+		flagValidatorStakingAmount:           &v.StakingAmount,
+		flagValidatorCommissionRate:          &v.CommissionRate,
+		flagValidatorCommissionMaxRate:       &v.CommissionMaxRate,
+		flagValidatorCommissionMaxChangeRate: &v.CommissionMaxChangeRate,
+	} {
+		if cmd.Flags().Changed(flag) {
+			*value, _ = cmd.Flags().GetString(flag)
+		}
+	}
+	return v, nil
+}
+
+// askValidatorInfo prompts to the user questions to query the validator information that is not set
+// and checks the validator.
+func askValidatorInfo(session cliui.Session, v chain.Validator, stakeDenom string) (chain.Validator, error) {
+	if v.GasPrices == "" {
+		v.GasPrices = "0" + stakeDenom
+	}
+
+	var questions []cliquiz.Question
+	for _, q := range []struct {
+		question      string
+		answer        *string
+		defaultAnswer string
+	}{
+		{"Staking amount", &v.StakingAmount, "95000000" + stakeDenom},
+		{"Commission rate", &v.CommissionRate, "0.10"},
+		{"Commission max rate", &v.CommissionMaxRate, "0.20"},
+		{"Commission max change rate", &v.CommissionMaxChangeRate, "0.01"},
+	} {
+		if *q.answer != "" {
+			continue
+		}
+		questions = append(questions, cliquiz.NewQuestion(q.question,
+			q.answer,
+			cliquiz.DefaultAnswer(q.defaultAnswer),
 			cliquiz.Required(),
-		),
-	)
-	return v, session.Ask(questions...)
+		))
+	}
+	if len(questions) > 0 {
+		if err := session.Ask(questions...); err != nil {
+			return v, err
+		}
+	}
+
+	return v, networkchain.CheckValidator(v, stakeDenom)
 }
@@ -5,7 +5,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/colors"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite-hq/cli/ignite/pkg/goenv"
@@ -13,22 +12,38 @@ import (
 	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
 )
 
+const (
+	flagFromCacheDir = "from-cache-dir"
+)
+
 // NewNetworkChainInstall returns a new command to install a chain's binary by the launch id.
 func NewNetworkChainInstall() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "install [launch-id]",
 		Short: "Install chain binary for a launch",
-		Args:  cobra.ExactArgs(1),
-		RunE:  networkChainInstallHandler,
+		Long: `Install the binary of a chain built from the source of the launch.
+
+Use --from-cache-dir to share the binaries with other validators through a directory, the binary
+is installed from the directory when it has a binary built from the same source for the same chain
+ID, otherwise the binary is built and saved in the directory. Binaries are built without file system
+paths so that they can be checked with "ignite network chain verify-binary".
+
+The checksum file saved next to a binary only detects a corrupted copy, anyone who can write to the
+directory can replace both. Run "ignite network chain verify-binary" to trust a shared binary, it
+rebuilds the binary from the source of the launch and only matches binaries built with the same Go
+version.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainInstallHandler,
 	}
 
 	flagSetClearCache(c)
+	c.Flags().String(flagFromCacheDir, "", "Directory of binaries shared with other validators")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	return c
 }
 
 func networkChainInstallHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	cacheStorage, err := newCache(cmd)
@@ -62,14 +77,34 @@ func networkChainInstallHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	binaryName, err := c.Build(cmd.Context(), cacheStorage)
-	if err != nil {
-		return err
+	var (
+		binaryName  string
+		fromCache   bool
+		cacheDir, _ = cmd.Flags().GetString(flagFromCacheDir)
+	)
+	if cacheDir != "" {
+		if binaryName, fromCache, err = c.InstallFromCacheDir(cacheDir); err != nil {
+			return err
+		}
+	}
+	if !fromCache {
+		if binaryName, err = c.Build(cmd.Context(), cacheStorage); err != nil {
+			return err
+		}
+		if cacheDir != "" {
+			if err := c.SaveToCacheDir(cmd.Context(), cacheStorage, cacheDir); err != nil {
+				return err
+			}
+		}
 	}
 	binaryPath := filepath.Join(goenv.Bin(), binaryName)
 
 	session.StopSpinner()
-	session.Printf("%s Binary installed\n", icons.OK)
+	if fromCache {
+		session.Printf("%s Binary installed from %s\n", icons.OK, cacheDir)
+	} else {
+		session.Printf("%s Binary installed\n", icons.OK)
+	}
 	session.Printf("%s Binary's name: %s\n", icons.Info, colors.Info(binaryName))
 	session.Printf("%s Binary's path: %s\n", icons.Info, colors.Info(binaryPath))
 
@@ -3,6 +3,7 @@ package ignitecmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -12,10 +13,12 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite-hq/cli/ignite/pkg/gitpod"
 	"github.com/ignite-hq/cli/ignite/pkg/xchisel"
 	"github.com/ignite-hq/cli/ignite/services/network"
 	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
 )
 
 const (
@@ -44,7 +47,7 @@ func NewNetworkChainJoin() *cobra.Command {
 }
 
 func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	var (
@@ -68,10 +71,10 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	// if there is no custom gentx, we need to detect the public address.
+	var publicAddr string
 	if gentxPath == "" {
 		// get the peer public address for the validator.
-		publicAddr, err := askPublicAddress(cmd.Context(), session)
-		if err != nil {
+		if publicAddr, err = askPublicAddress(cmd.Context(), session); err != nil {
 			return err
 		}
 
@@ -100,13 +103,23 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 			return errors.Wrap(err, "error parsing amount")
 		}
 		joinOptions = append(joinOptions, network.WithAccountRequest(amountCoins))
-	} else {
+	}
+
+	// show the validator of the gentx before sending the request.
+	if err := printJoinPreview(cmd.Context(), session, c, gentxPath, publicAddr, amount); err != nil {
+		return err
+	}
+
+	if amount == "" {
 		if !getYes(cmd) {
 			question := fmt.Sprintf(
 				"You haven't set the --%s flag and therefore an account request won't be submitted. Do you confirm",
 				flagAmount,
 			)
 			if err := session.AskConfirm(question); err != nil {
+				if errors.Is(err, cliquiz.ErrNonInteractive) {
+					return errors.Wrapf(err, "use --%s to confirm", flagYes)
+				}
 				return session.PrintSaidNo()
 			}
 		}
@@ -118,11 +131,79 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 	return n.Join(cmd.Context(), c, launchID, joinOptions...)
 }
 
+// printJoinPreview prints the validator created by the gentx of the chain or the custom gentx.
+func printJoinPreview(
+	ctx context.Context,
+	session cliui.Session,
+	c *networkchain.Chain,
+	gentxPath,
+	publicAddr,
+	amount string,
+) error {
+	isCustomGentx := gentxPath != ""
+	if !isCustomGentx {
+		var err error
+		if gentxPath, err = c.DefaultGentxPath(); err != nil {
+			return err
+		}
+	}
+
+	info, _, err := cosmosutil.GentxFromPath(gentxPath)
+	if err != nil {
+		return err
+	}
+
+	address, err := cosmosutil.ChangeAddressPrefix(info.DelegatorAddress, networktypes.SPN)
+	if err != nil {
+		return err
+	}
+
+	peer := info.Memo
+	if !isCustomGentx {
+		nodeID, err := c.NodeID(ctx)
+		if err != nil {
+			return err
+		}
+		peer = fmt.Sprintf("%s@%s", nodeID, publicAddr)
+	}
+
+	if amount == "" {
+		amount = "-"
+	}
+
+	minSelfDelegation := "-"
+	if !info.MinSelfDelegation.IsNil() {
+		minSelfDelegation = info.MinSelfDelegation.String()
+	}
+
+	session.StopSpinner()
+	return session.PrintTable([]string{"validator", ""},
+		[]string{"moniker", info.Moniker},
+		[]string{"address", address},
+		[]string{"self-delegation", info.SelfDelegation.String()},
+		[]string{"min self-delegation", minSelfDelegation},
+		[]string{"commission rate", formatRate(info.Commission.Rate)},
+		[]string{"commission max rate", formatRate(info.Commission.MaxRate)},
+		[]string{"commission max change rate", formatRate(info.Commission.MaxChangeRate)},
+		[]string{"peer", peer},
+		[]string{"account request", amount},
+	)
+}
+
+// formatRate formats a rate without its trailing zeros.
+func formatRate(rate sdk.Dec) string {
+	if rate.IsNil() {
+		return "-"
+	}
+	return strings.TrimRight(strings.TrimRight(rate.String(), "0"), ".")
+}
+
 // askPublicAddress prepare questions to interactively ask for a publicAddress
 // when peer isn't provided and not running through chisel proxy.
 func askPublicAddress(ctx context.Context, session cliui.Session) (publicAddress string, err error) {
 	options := []cliquiz.Option{
 		cliquiz.Required(),
+		cliquiz.Key("peer_address"),
 	}
 	if gitpod.IsOnGitpod() {
 		publicAddress, err = gitpod.URLForPort(ctx, xchisel.DefaultServerPort)
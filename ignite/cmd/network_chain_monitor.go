@@ -0,0 +1,248 @@
+package ignitecmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite-hq/cli/ignite/pkg/ctxticker"
+	"github.com/ignite-hq/cli/ignite/services/network"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+const (
+	flagRPCAddress   = "rpc-address"
+	flagCreateClient = "create-client"
+
+	// defaultRPCPort is the RPC port of the validators that is used with the host of their peer.
+	defaultRPCPort = "26657"
+
+	// monitorMaxBlocks is the maximum number of blocks added at once by the monitor.
+	monitorMaxBlocks = 100
+)
+
+// NewNetworkChainMonitor creates a new chain monitor command to follow a launched chain.
+func NewNetworkChainMonitor() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "monitor [launch-id]",
+		Short: "Follow the blocks and the validators of a launched chain",
+		Long: `Follow a launched chain through the RPC endpoints of its genesis validators and show the
+block height and time, the validators that are signing, their missed blocks and the percentage of
+the voting power online.
+
+The RPC endpoints are the hosts of the validator peers with the default RPC port, use --rpc-address
+to add other endpoints. The signatures are counted like the monitoring module of the chain does to
+build the monitoring packet for the SPN rewards. Use --create-client to create the IBC client of the
+chain on SPN, the monitoring packet is sent by the chain once the client is connected by a relayer.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainMonitorHandler,
+	}
+
+	c.Flags().StringSlice(flagRPCAddress, nil, "RPC address of a node of the chain")
+	c.Flags().Duration(flagInterval, 5*time.Second, "Interval to check for new blocks")
+	c.Flags().Bool(flagCreateClient, false, "Create the IBC client of the chain on SPN for the monitoring packet")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainMonitorHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	var (
+		rpcAddresses, _ = cmd.Flags().GetStringSlice(flagRPCAddress)
+		interval, _     = cmd.Flags().GetDuration(flagInterval)
+		createClient, _ = cmd.Flags().GetBool(flagCreateClient)
+	)
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+	if !chainLaunch.LaunchTriggered {
+		return fmt.Errorf("the launch of the chain %d is not triggered", launchID)
+	}
+
+	genVals, err := n.GenesisValidators(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	monitor, err := network.NewChainMonitor(genVals)
+	if err != nil {
+		return err
+	}
+
+	m := chainMonitor{
+		session:      session,
+		n:            n,
+		chainLaunch:  chainLaunch,
+		monitor:      monitor,
+		rpcAddresses: append(rpcAddresses, validatorRPCAddresses(genVals)...),
+		createClient: createClient,
+	}
+
+	launchTime := time.Unix(chainLaunch.LaunchTime, 0)
+	if wait := time.Until(launchTime); wait > 0 {
+		session.StartSpinner(fmt.Sprintf("Waiting for the launch of the chain at %s...", launchTime.Format(time.RFC1123)))
+	} else {
+		session.StartSpinner("Looking for a node of the chain...")
+	}
+
+	return ctxticker.DoNow(cmd.Context(), interval, func() error {
+		// the monitor keeps running while the nodes of the chain cannot be reached.
+		if err := m.update(cmd.Context()); err != nil {
+			session.StopSpinner()
+			session.Printf("%s %s\n", icons.NotOK, err)
+		}
+		return nil
+	})
+}
+
+// chainMonitor follows a launched chain through the first node that answers.
+type chainMonitor struct {
+	session      cliui.Session
+	n            network.Network
+	chainLaunch  networktypes.ChainLaunch
+	monitor      *network.ChainMonitor
+	rpcAddresses []string
+	createClient bool
+
+	node          *rpchttp.HTTP
+	nodeAddress   string
+	clientCreated bool
+}
+
+// update syncs the monitor with the new blocks of the chain and prints its status.
+func (m *chainMonitor) update(ctx context.Context) error {
+	if m.node == nil {
+		if err := m.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := m.monitor.Sync(ctx, m.node, monitorMaxBlocks); err != nil {
+		// another node is used when the node stops answering.
+		m.node = nil
+		return errors.Wrapf(err, "cannot fetch the blocks from %s", m.nodeAddress)
+	}
+	if m.monitor.Height() == 0 {
+		return nil
+	}
+
+	m.session.StopSpinner()
+
+	if m.createClient && !m.clientCreated {
+		clientID, err := m.createSPNClient(ctx)
+		if err != nil {
+			return errors.Wrap(err, "cannot create the client on SPN")
+		}
+		m.clientCreated = true
+		m.session.Printf("%s Client created on SPN: %s\n", icons.OK, clientID)
+	}
+
+	return m.printStatus(m.monitor.Status())
+}
+
+// connect connects to the first node of the chain that answers with the chain ID.
+func (m *chainMonitor) connect(ctx context.Context) error {
+	for _, address := range m.rpcAddresses {
+		node, err := rpchttp.New(address, "/websocket")
+		if err != nil {
+			continue
+		}
+		status, err := node.Status(ctx)
+		if err != nil || status.NodeInfo.Network != m.chainLaunch.ChainID {
+			continue
+		}
+		m.node = node
+		m.nodeAddress = address
+		return nil
+	}
+	return fmt.Errorf("no node of the chain %s can be reached", m.chainLaunch.ChainID)
+}
+
+// createSPNClient creates the IBC client of the chain on SPN from the node.
+func (m *chainMonitor) createSPNClient(ctx context.Context) (string, error) {
+	nodeClient, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(m.nodeAddress))
+	if err != nil {
+		return "", err
+	}
+	node, err := network.NewNodeClient(nodeClient)
+	if err != nil {
+		return "", err
+	}
+
+	rewardsInfo, unboundingTime, err := node.RewardsInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	return m.n.CreateClient(m.chainLaunch.ID, unboundingTime, rewardsInfo)
+}
+
+func (m *chainMonitor) printStatus(status network.MonitorStatus) error {
+	return m.session.PrintResult(status, func() error {
+		var entries [][]string
+		for _, val := range status.Validators {
+			signing := icons.OK
+			if !val.Signing {
+				signing = icons.NotOK
+			}
+			entries = append(entries, []string{
+				val.Address,
+				signing,
+				fmt.Sprintf("%d", val.MissedBlocks),
+				fmt.Sprintf("%d", val.VotingPower),
+			})
+		}
+
+		if err := m.session.Printf(
+			"%s Height %d at %s, %.2f%% of the voting power online\n",
+			icons.Info,
+			status.Height,
+			status.Time.Format(time.RFC3339),
+			status.OnlineVotingPower,
+		); err != nil {
+			return err
+		}
+		return m.session.PrintTable([]string{"Validator", "Signing", "Missed Blocks", "Voting Power"}, entries...)
+	})
+}
+
+// validatorRPCAddresses returns the RPC addresses of the validators from the host of their peer,
+// validators connected through an HTTP tunnel have no public RPC address.
+func validatorRPCAddresses(genVals []networktypes.GenesisValidator) []string {
+	var addresses []string
+	for _, val := range genVals {
+		conn, ok := val.Peer.Connection.(*launchtypes.Peer_TcpAddress)
+		if !ok {
+			continue
+		}
+		host, _, err := net.SplitHostPort(conn.TcpAddress)
+		if err != nil {
+			continue
+		}
+		addresses = append(addresses, "http://"+net.JoinHostPort(host, defaultRPCPort))
+	}
+	return addresses
+}
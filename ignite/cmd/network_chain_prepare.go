@@ -2,20 +2,25 @@ package ignitecmd
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/colors"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite-hq/cli/ignite/pkg/goenv"
 	"github.com/ignite-hq/cli/ignite/services/network"
 	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
 )
 
 const (
-	flagForce = "force"
+	flagForce      = "force"
+	flagFromBundle = "from-bundle"
 )
 
 // NewNetworkChainPrepare returns a new command to prepare the chain for launch
@@ -23,12 +28,18 @@ func NewNetworkChainPrepare() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "prepare [launch-id]",
 		Short: "Prepare the chain for launch",
-		Args:  cobra.ExactArgs(1),
-		RunE:  networkChainPrepareHandler,
+		Long: `Prepare the chain for launch from the information of SPN.
+
+Use --from-bundle with a launch bundle exported by "ignite network chain export" to prepare the
+chain without SPN, the hash of the prepared genesis is checked against the hash of the genesis
+of the bundle.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: networkChainPrepareHandler,
 	}
 
 	flagSetClearCache(c)
 	c.Flags().BoolP(flagForce, "f", false, "Force the prepare command to run even if the chain is not launched")
+	c.Flags().String(flagFromBundle, "", "Path to a launch bundle to prepare the chain from")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetHome())
@@ -37,16 +48,26 @@ func NewNetworkChainPrepare() *cobra.Command {
 }
 
 func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
-	force, _ := cmd.Flags().GetBool(flagForce)
+	var (
+		force, _      = cmd.Flags().GetBool(flagForce)
+		bundlePath, _ = cmd.Flags().GetString(flagFromBundle)
+	)
 
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
 	}
 
+	if bundlePath != "" {
+		return networkChainPrepareFromBundle(cmd, args, session, cacheStorage, bundlePath, force)
+	}
+	if len(args) == 0 {
+		return errors.New("a launch ID or a launch bundle with --from-bundle is required")
+	}
+
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
 	if err != nil {
 		return err
@@ -110,6 +131,99 @@ func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	return printChainPrepared(session, c)
+}
+
+// networkChainPrepareFromBundle prepares the chain from the information of a launch bundle
+// and checks that the prepared genesis is the genesis of the bundle, SPN is never reached.
+func networkChainPrepareFromBundle(
+	cmd *cobra.Command,
+	args []string,
+	session cliui.Session,
+	cacheStorage cache.Storage,
+	bundlePath string,
+	force bool,
+) error {
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	bundle, err := network.ReadBundle(f)
+	if err != nil {
+		return err
+	}
+
+	// the launch ID is optional with a bundle but it must match the bundle when provided
+	if len(args) > 0 {
+		launchID, err := network.ParseID(args[0])
+		if err != nil {
+			return err
+		}
+		if launchID != bundle.ChainLaunch.ID {
+			return fmt.Errorf("the launch bundle is for the chain %d", bundle.ChainLaunch.ID)
+		}
+	}
+
+	if !force && !bundle.ChainLaunch.LaunchTriggered {
+		return fmt.Errorf("chain %d launch has not been triggered yet. use --force to prepare anyway", bundle.ChainLaunch.ID)
+	}
+
+	c, err := newBundleChain(cmd, session, bundle)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Prepare(
+		cmd.Context(),
+		cacheStorage,
+		bundle.GenesisInformation,
+		bundle.RewardsInfo,
+		bundle.SPNChainID,
+		bundle.LastBlockHeight,
+		bundle.UnbondingTime,
+	); err != nil {
+		return err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return err
+	}
+	if hash := cosmosutil.GenesisHash(genesis); hash != bundle.GenesisHash() {
+		return fmt.Errorf(
+			"the prepared genesis has the hash %s, the genesis of the launch bundle has the hash %s",
+			hash,
+			bundle.GenesisHash(),
+		)
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Genesis hash verified: %s\n", icons.OK, bundle.GenesisHash())
+
+	return printChainPrepared(session, c)
+}
+
+// newBundleChain creates the chain of the launch of bundle without connecting to SPN.
+func newBundleChain(cmd *cobra.Command, session cliui.Session, bundle network.LaunchBundle) (*networkchain.Chain, error) {
+	nb, err := newLocalNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return nil, err
+	}
+
+	return nb.Chain(
+		networkchain.SourceLaunch(bundle.ChainLaunch),
+		networkchain.WithInitialGenesis(bundle.InitialGenesis),
+	)
+}
+
+// printChainPrepared prints the command to start the node of the prepared chain.
+func printChainPrepared(session cliui.Session, c *networkchain.Chain) error {
 	chainHome, err := c.Home()
 	if err != nil {
 		return err
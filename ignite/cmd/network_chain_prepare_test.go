@@ -0,0 +1,113 @@
+package ignitecmd
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/services/network"
+	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+// unreachableSPNNodeAddress is the address of an SPN node that cannot be reached.
+const unreachableSPNNodeAddress = "http://127.0.0.1:1"
+
+// newTestChainSource creates a git repository with the source of a chain and returns
+// its path and the hash of its commit.
+func newTestChainSource(t *testing.T) (path, hash string) {
+	path = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(path, "go.mod"), []byte(`module github.com/test/mars
+
+go 1.18
+
+require github.com/cosmos/cosmos-sdk v0.45.4
+`), 0644))
+
+	repo, err := git.PlainInit(path, false)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = wt.Add("go.mod")
+	require.NoError(t, err)
+	commit, err := wt.Commit("init", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@test.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	return path, commit.String()
+}
+
+// writeTestBundle writes b to a bundle file and returns its path.
+func writeTestBundle(t *testing.T, b network.LaunchBundle) string {
+	path := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.NoError(t, network.WriteBundle(f, b))
+	return path
+}
+
+func TestNetworkChainPrepareFromBundleWithoutSPN(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	bundlePath := writeTestBundle(t, network.LaunchBundle{
+		ChainLaunch: networktypes.ChainLaunch{ID: 1, ChainID: "mars-1"},
+		Genesis:     []byte("{}"),
+	})
+
+	cmd := New()
+	cmd.SetArgs([]string{
+		"network", "chain", "prepare",
+		"--from-bundle", bundlePath,
+		"--spn-node-address", unreachableSPNNodeAddress,
+		"--keyring-backend", "test",
+		"--output-format", "json",
+	})
+
+	// the launch isn't triggered, the bundle is read and checked without connecting to SPN.
+	err := cmd.ExecuteContext(context.Background())
+	require.EqualError(t, err, "chain 1 launch has not been triggered yet. use --force to prepare anyway")
+	require.Nil(t, cosmos)
+}
+
+func TestNewBundleChainWithoutSPN(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	sourcePath, sourceHash := newTestChainSource(t)
+	bundle := network.LaunchBundle{
+		ChainLaunch: networktypes.ChainLaunch{
+			ID:              1,
+			ChainID:         "mars-1",
+			SourceURL:       sourcePath,
+			SourceHash:      sourceHash,
+			LaunchTriggered: true,
+		},
+	}
+
+	session := cliui.New(cliui.WithOutput(io.Discard), cliui.WithOutputFormat(cliui.OutputJSON))
+	defer session.Cleanup()
+
+	var c *networkchain.Chain
+	cmd := NewNetworkChainPrepare()
+	cmd.RunE = func(cmd *cobra.Command, _ []string) (err error) {
+		c, err = newBundleChain(cmd, session, bundle)
+		return err
+	}
+	cmd.SetArgs([]string{"--keyring-backend", "test"})
+
+	require.NoError(t, cmd.ExecuteContext(context.Background()))
+	require.Equal(t, sourceHash, c.SourceHash())
+	require.Equal(t, sourcePath, c.SourceURL())
+	require.Nil(t, cosmos)
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
 )
@@ -15,6 +14,24 @@ var (
 	chainVestingAccSummaryHeader = []string{"Vesting Account", "Total Balance", "Vesting", "EndTime"}
 )
 
+// chainAccountsResult is the account list of a chain printed in JSON output.
+type chainAccountsResult struct {
+	GenesisAccounts []genesisAccountResult `json:"genesis_accounts"`
+	VestingAccounts []vestingAccountResult `json:"vesting_accounts"`
+}
+
+type genesisAccountResult struct {
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
+}
+
+type vestingAccountResult struct {
+	Address      string `json:"address"`
+	TotalBalance string `json:"total_balance"`
+	Vesting      string `json:"vesting"`
+	EndTime      int64  `json:"end_time"`
+}
+
 func newNetworkChainShowAccounts() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "accounts [launch-id]",
@@ -29,7 +46,7 @@ func newNetworkChainShowAccounts() *cobra.Command {
 }
 
 func networkChainShowAccountsHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	addressPrefix := getAddressPrefix(cmd)
@@ -51,11 +68,11 @@ func networkChainShowAccountsHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	if len(genesisAccs)+len(vestingAccs) == 0 {
-		session.StopSpinner()
-		return session.Printf("%s %s\n", icons.Info, "empty chain account list")
-	}
 
+	result := chainAccountsResult{
+		GenesisAccounts: make([]genesisAccountResult, 0),
+		VestingAccounts: make([]vestingAccountResult, 0),
+	}
 	genesisAccEntries := make([][]string, 0)
 	for _, acc := range genesisAccs {
 		address, err := cosmosutil.ChangeAddressPrefix(acc.Address, addressPrefix)
@@ -63,6 +80,10 @@ func networkChainShowAccountsHandler(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		result.GenesisAccounts = append(result.GenesisAccounts, genesisAccountResult{
+			Address: address,
+			Coins:   acc.Coins,
+		})
 		genesisAccEntries = append(genesisAccEntries, []string{address, acc.Coins})
 	}
 	genesisVestingAccEntries := make([][]string, 0)
@@ -72,6 +93,12 @@ func networkChainShowAccountsHandler(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		result.VestingAccounts = append(result.VestingAccounts, vestingAccountResult{
+			Address:      address,
+			TotalBalance: acc.TotalBalance,
+			Vesting:      acc.Vesting,
+			EndTime:      acc.EndTime,
+		})
 		genesisVestingAccEntries = append(genesisVestingAccEntries, []string{
 			address,
 			acc.TotalBalance,
@@ -81,16 +108,21 @@ func networkChainShowAccountsHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	session.StopSpinner()
-	if len(genesisAccEntries) > 0 {
-		if err = session.PrintTable(chainGenesisAccSummaryHeader, genesisAccEntries...); err != nil {
-			return err
+
+	return session.PrintResult(result, func() error {
+		if len(genesisAccEntries)+len(genesisVestingAccEntries) == 0 {
+			return session.Printf("%s %s\n", icons.Info, "empty chain account list")
 		}
-	}
-	if len(genesisVestingAccEntries) > 0 {
-		if err = session.PrintTable(chainVestingAccSummaryHeader, genesisVestingAccEntries...); err != nil {
-			return err
+		if len(genesisAccEntries) > 0 {
+			if err = session.PrintTable(chainGenesisAccSummaryHeader, genesisAccEntries...); err != nil {
+				return err
+			}
 		}
-	}
-
-	return nil
+		if len(genesisVestingAccEntries) > 0 {
+			if err = session.PrintTable(chainVestingAccSummaryHeader, genesisVestingAccEntries...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
@@ -6,7 +6,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
 )
@@ -26,7 +25,7 @@ func newNetworkChainShowGenesis() *cobra.Command {
 }
 
 func networkChainShowGenesisHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	out, _ := cmd.Flags().GetString(flagOut)
@@ -120,5 +119,13 @@ func networkChainShowGenesisHandler(cmd *cobra.Command, args []string) error {
 
 	session.StopSpinner()
 
-	return session.Printf("%s Genesis generated: %s\n", icons.Bullet, out)
+	result := struct {
+		GenesisPath string `json:"genesis_path"`
+	}{
+		GenesisPath: out,
+	}
+
+	return session.PrintResult(result, func() error {
+		return session.Printf("%s Genesis generated: %s\n", icons.Bullet, out)
+	})
 }
@@ -1,9 +1,10 @@
 package ignitecmd
 
 import (
+	"encoding/json"
+
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite-hq/cli/ignite/pkg/yaml"
 	"github.com/ignite-hq/cli/ignite/services/network"
@@ -21,7 +22,7 @@ func newNetworkChainShowInfo() *cobra.Command {
 }
 
 func networkChainShowInfoHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, launchID, err := networkChainLaunch(cmd, args, session)
@@ -51,19 +52,30 @@ func networkChainShowInfoHandler(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	}
-	chainInfo := struct {
+
+	session.StopSpinner()
+
+	result := struct {
 		Chain   networktypes.ChainLaunch `json:"Chain"`
-		Genesis []byte                   `json:"Genesis"`
+		Genesis json.RawMessage          `json:"Genesis,omitempty"`
 	}{
 		Chain:   chainLaunch,
 		Genesis: genesis,
 	}
-	info, err := yaml.Marshal(cmd.Context(), chainInfo, "$.Genesis")
-	if err != nil {
-		return err
-	}
 
-	session.StopSpinner()
+	return session.PrintResult(result, func() error {
+		chainInfo := struct {
+			Chain   networktypes.ChainLaunch `json:"Chain"`
+			Genesis []byte                   `json:"Genesis"`
+		}{
+			Chain:   chainLaunch,
+			Genesis: genesis,
+		}
+		info, err := yaml.Marshal(cmd.Context(), chainInfo, "$.Genesis")
+		if err != nil {
+			return err
+		}
 
-	return session.Print(info)
+		return session.Print(info)
+	})
 }
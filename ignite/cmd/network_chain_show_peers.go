@@ -9,11 +9,31 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite-hq/cli/ignite/services/network"
 )
 
+const flagCheck = "check"
+
+type (
+	// peersResult is the peers list printed in JSON output.
+	peersResult struct {
+		PeersPath string            `json:"peers_path,omitempty"`
+		Peers     []string          `json:"peers"`
+		Checks    []peerCheckResult `json:"checks,omitempty"`
+	}
+
+	// peerCheckResult is the verification of the gentx and the peer of a validator,
+	// the errors are empty when the checks pass.
+	peerCheckResult struct {
+		Validator           string `json:"validator"`
+		Peer                string `json:"peer"`
+		GentxSignatureError string `json:"gentx_signature_error,omitempty"`
+		HandshakeError      string `json:"handshake_error,omitempty"`
+	}
+)
+
 func newNetworkChainShowPeers() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "peers [launch-id]",
@@ -23,15 +43,17 @@ func newNetworkChainShowPeers() *cobra.Command {
 	}
 
 	c.Flags().String(flagOut, "./peers.txt", "Path to output peers list")
+	c.Flags().Bool(flagCheck, false, "Verify the gentx signatures of the validators and dial their peers to check their node ID")
 
 	return c
 }
 
 func networkChainShowPeersHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	out, _ := cmd.Flags().GetString(flagOut)
+	check, _ := cmd.Flags().GetBool(flagCheck)
 
 	nb, launchID, err := networkChainLaunch(cmd, args, session)
 	if err != nil {
@@ -57,9 +79,30 @@ func networkChainShowPeersHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(peers) == 0 {
-		session.Printf("%s %s\n", icons.Info, "no peers found")
-		return nil
+		session.StopSpinner()
+		return session.PrintResult(peersResult{Peers: peers}, func() error {
+			return session.Printf("%s %s\n", icons.Info, "no peers found")
+		})
+	}
+
+	var checks []peerCheckResult
+	if check {
+		chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+		if err != nil {
+			return err
+		}
 
+		session.StartSpinner("Checking the validators...")
+		for i, val := range genVals {
+			result := peerCheckResult{Validator: val.Address, Peer: peers[i]}
+			if err := cosmosutil.VerifyGentxSignature(val.Gentx, chainLaunch.ChainID); err != nil {
+				result.GentxSignatureError = err.Error()
+			}
+			if err := network.VerifyPeer(cmd.Context(), val.Peer); err != nil {
+				result.HandshakeError = err.Error()
+			}
+			checks = append(checks, result)
+		}
 	}
 
 	if err := os.MkdirAll(filepath.Dir(out), 0744); err != nil {
@@ -75,5 +118,29 @@ func networkChainShowPeersHandler(cmd *cobra.Command, args []string) error {
 
 	session.StopSpinner()
 
-	return session.Printf("%s Peer list generated: %s\n", icons.Bullet, out)
+	return session.PrintResult(peersResult{PeersPath: out, Peers: peers, Checks: checks}, func() error {
+		if check {
+			var entries [][]string
+			for _, result := range checks {
+				entries = append(entries, []string{
+					result.Validator,
+					result.Peer,
+					checkStatus(result.GentxSignatureError),
+					checkStatus(result.HandshakeError),
+				})
+			}
+			if err := session.PrintTable([]string{"Validator", "Peer", "Gentx Signature", "Handshake"}, entries...); err != nil {
+				return err
+			}
+		}
+		return session.Printf("%s Peer list generated: %s\n", icons.Bullet, out)
+	})
+}
+
+// checkStatus returns the status of a check from its error message.
+func checkStatus(errMessage string) string {
+	if errMessage == "" {
+		return icons.OK
+	}
+	return fmt.Sprintf("%s %s", icons.NotOK, errMessage)
 }
@@ -3,7 +3,6 @@ package ignitecmd
 import (
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite-hq/cli/ignite/services/network"
@@ -13,6 +12,13 @@ var (
 	chainGenesisValSummaryHeader = []string{"Genesis Validator", "Self Delegation", "Peer"}
 )
 
+// validatorResult is a genesis validator printed in JSON output.
+type validatorResult struct {
+	Address        string `json:"address"`
+	SelfDelegation string `json:"self_delegation"`
+	Peer           string `json:"peer"`
+}
+
 func newNetworkChainShowValidators() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "validators [launch-id]",
@@ -27,7 +33,7 @@ func newNetworkChainShowValidators() *cobra.Command {
 }
 
 func networkChainShowValidatorsHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	addressPrefix := getAddressPrefix(cmd)
@@ -45,6 +51,7 @@ func networkChainShowValidatorsHandler(cmd *cobra.Command, args []string) error
 	if err != nil {
 		return err
 	}
+	result := make([]validatorResult, 0)
 	validatorEntries := make([][]string, 0)
 	for _, acc := range validators {
 		peer, err := network.PeerAddress(acc.Peer)
@@ -57,17 +64,24 @@ func networkChainShowValidatorsHandler(cmd *cobra.Command, args []string) error
 			return err
 		}
 
+		result = append(result, validatorResult{
+			Address:        address,
+			SelfDelegation: acc.SelfDelegation.String(),
+			Peer:           peer,
+		})
 		validatorEntries = append(validatorEntries, []string{
 			address,
 			acc.SelfDelegation.String(),
 			peer,
 		})
 	}
-	if len(validatorEntries) == 0 {
-		return session.Printf("%s %s\n", icons.Info, "no account found")
-	}
 
 	session.StopSpinner()
 
-	return session.PrintTable(chainGenesisValSummaryHeader, validatorEntries...)
+	return session.PrintResult(result, func() error {
+		if len(validatorEntries) == 0 {
+			return session.Printf("%s %s\n", icons.Info, "no account found")
+		}
+		return session.PrintTable(chainGenesisValSummaryHeader, validatorEntries...)
+	})
 }
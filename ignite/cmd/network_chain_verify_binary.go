@@ -0,0 +1,80 @@
+package ignitecmd
+
+import (
+	"os/exec"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+)
+
+// NewNetworkChainVerifyBinary returns a new command to verify a chain's binary against the source of a launch.
+func NewNetworkChainVerifyBinary() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "verify-binary [launch-id] [binary]",
+		Short: "Verify that a binary is built from the source of a launch",
+		Long: `Rebuild the chain from the source URL and hash of the launch and compare the checksum
+of the rebuilt binary with the checksum of the binary. The checksums only match when both binaries
+are built with the same Go version.
+
+The binary is a path or the name of a binary in the PATH.`,
+		Args: cobra.ExactArgs(2),
+		RunE: networkChainVerifyBinaryHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	return c
+}
+
+func networkChainVerifyBinaryHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	binaryPath, err := exec.LookPath(args[1])
+	if err != nil {
+		return err
+	}
+
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	sourceChecksum, binaryChecksum, err := c.VerifyBinary(cmd.Context(), cacheStorage, binaryPath)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	session.Printf("%s Source: %s@%s\n", icons.Info, colors.Info(chainLaunch.SourceURL), colors.Info(chainLaunch.SourceHash))
+	session.Printf("%s Binary's checksum: %s\n", icons.Info, colors.Info(binaryChecksum))
+	session.Printf("%s Source's checksum: %s\n", icons.Info, colors.Info(sourceChecksum))
+
+	if sourceChecksum != binaryChecksum {
+		return errors.Errorf("the binary %s is not built from the source of the launch %d", binaryPath, launchID)
+	}
+	return session.Printf("%s Binary %s is built from the source of the launch %d\n", icons.OK, binaryPath, launchID)
+}
@@ -0,0 +1,414 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"syscall"
+
+	"github.com/otiai10/copy"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/availableport"
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/xurl"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+const (
+	flagSPNVersion         = "spn-version"
+	flagCoordinatorAccount = "coordinator-account"
+	flagDevnetCoins        = "coins"
+
+	// spnModulePath is the Go module of SPN.
+	spnModulePath = "github.com/tendermint/spn"
+
+	// devnetDir is the dir in the Ignite config dir that keeps the source, the home,
+	// the cache and the state of the devnet.
+	devnetDir = "network-devnet"
+
+	// devnetStateFile is the file in the devnet dir that holds the addresses of
+	// the running devnet.
+	devnetStateFile = "devnet.json"
+
+	// devnetValidatorAccount is the default account of the validators on the devnet.
+	devnetValidatorAccount = "validator"
+
+	// devnetPorts is the number of ports used by the devnet: RPC, P2P, Prof, gRPC,
+	// gRPC-Web, API and faucet.
+	devnetPorts = 7
+)
+
+var devnetAccountsHeader = []string{"Role", "Account", "Address", "Coins"}
+
+// devnetState is the state of a running devnet. network commands read it to use the devnet.
+type devnetState struct {
+	PID           int    `json:"pid"`
+	NodeAddress   string `json:"node_address"`
+	FaucetAddress string `json:"faucet_address"`
+}
+
+// NewNetworkDevnet returns a new command to serve a local SPN chain for the network commands.
+func NewNetworkDevnet() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "devnet",
+		Short: "Serve a local SPN chain with funded coordinator and validator accounts",
+		Long: `Serve a local SPN chain with funded coordinator and validator accounts.
+
+The SPN version used by Ignite CLI is downloaded and served with its state reset on ports
+that are not in use. The coordinator and validator accounts are created in the keyring when
+they don't exist and receive coins in the genesis.
+
+While the devnet runs, network commands use it by default unless --spn-node-address,
+--local or --nightly are set, so the publish, join, approve and launch cycle can run on
+a single machine.
+`,
+		Args: cobra.NoArgs,
+		RunE: networkDevnetHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagPath, "", "Path of the SPN source to serve instead of the SPN version used by Ignite CLI")
+	c.Flags().String(flagSPNVersion, "", "SPN version to download, by default the version used by Ignite CLI")
+	c.Flags().String(flagCoordinatorAccount, cosmosaccount.DefaultAccount, "Account of the coordinator")
+	c.Flags().String(flagValidatorAccount, devnetValidatorAccount, "Account of the validator")
+	c.Flags().String(flagDevnetCoins, "1000000000"+networktypes.SPNDenom, "Coins of the coordinator and validator accounts")
+	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+
+	return c
+}
+
+func networkDevnetHandler(cmd *cobra.Command, _ []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	var (
+		coordinator, _ = cmd.Flags().GetString(flagCoordinatorAccount)
+		validator, _   = cmd.Flags().GetString(flagValidatorAccount)
+		coins, _       = cmd.Flags().GetString(flagDevnetCoins)
+		sourcePath, _  = cmd.Flags().GetString(flagPath)
+	)
+
+	if state, ok := runningDevnet(); ok {
+		return fmt.Errorf("a devnet is already running at %s", state.NodeAddress)
+	}
+
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(configDir, devnetDir)
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	var accounts []chainconfig.Account
+	for _, name := range []string{coordinator, validator} {
+		account, err := ensureAccount(session, ca, name)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, chainconfig.Account{
+			Name:    name,
+			Address: account.Address(networktypes.SPN),
+			Coins:   []string{coins},
+		})
+	}
+
+	if sourcePath == "" {
+		session.StartSpinner("Downloading SPN...")
+
+		if sourcePath, err = downloadSPN(cmd, dir); err != nil {
+			return err
+		}
+	}
+
+	ports, err := availableport.Find(devnetPorts)
+	if err != nil {
+		return err
+	}
+
+	address := func(port int) string {
+		return fmt.Sprintf("0.0.0.0:%d", port)
+	}
+
+	logLevel := chain.LogSilent
+	if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+		logLevel = chain.LogVerbose
+	}
+
+	c, err := chain.New(sourcePath,
+		chain.LogLevel(logLevel),
+		chain.Host(chainconfig.Host{
+			RPC:     address(ports[0]),
+			P2P:     address(ports[1]),
+			Prof:    address(ports[2]),
+			GRPC:    address(ports[3]),
+			GRPCWeb: address(ports[4]),
+			API:     address(ports[5]),
+		}),
+		chain.FaucetHost(address(ports[6])),
+		chain.Accounts(accounts...),
+	)
+	if err != nil {
+		return err
+	}
+
+	// the devnet doesn't share the home and the cache of the chains served by "chain serve".
+	c.SetHome(filepath.Join(dir, "home"))
+
+	cacheStorage, err := cache.NewStorage(filepath.Join(dir, cacheFileName))
+	if err != nil {
+		return err
+	}
+	if flagGetClearCache(cmd) {
+		if err := cacheStorage.Clear(); err != nil {
+			return err
+		}
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	state := devnetState{PID: os.Getpid()}
+	state.NodeAddress, _ = xurl.HTTP(conf.Host.RPC)
+	state.FaucetAddress, _ = xurl.HTTP(chainconfig.FaucetHost(conf))
+
+	g, ctx := errgroup.WithContext(cmd.Context())
+
+	g.Go(func() error {
+		return c.Serve(ctx, cacheStorage, chain.ServeResetOnce())
+	})
+
+	g.Go(func() error {
+		defer session.StopSpinner()
+
+		session.StartSpinner("Building and starting SPN (use -v to see its logs)...")
+
+		if err := waitForChain(ctx, networktypes.SPNChainID, state.NodeAddress); err != nil {
+			return err
+		}
+
+		if err := saveDevnetState(state); err != nil {
+			return err
+		}
+
+		session.StopSpinner()
+
+		return printDevnetSummary(session, state, accounts, []string{"Coordinator", "Validator"})
+	})
+
+	defer removeDevnetState()
+
+	return g.Wait()
+}
+
+// ensureAccount returns the account with name, the account is created when it doesn't exist.
+func ensureAccount(session cliui.Session, ca cosmosaccount.Registry, name string) (cosmosaccount.Account, error) {
+	account, err := ca.GetByName(name)
+
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return account, err
+	}
+
+	account, mnemonic, err := ca.Create(name)
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+
+	return account, session.Printf("%s Account %q created, keep your mnemonic in a secret place:\n\n%s\n\n",
+		icons.OK, name, mnemonic)
+}
+
+// downloadSPN downloads the SPN source and copies it to dir to be served, the module
+// cache is read-only. the source of a version is only copied once.
+func downloadSPN(cmd *cobra.Command, dir string) (path string, err error) {
+	version, _ := cmd.Flags().GetString(flagSPNVersion)
+	if version == "" {
+		if version, err = spnVersion(); err != nil {
+			return "", err
+		}
+	}
+
+	path = filepath.Join(dir, "spn@"+version)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	out := &bytes.Buffer{}
+	if err := cmdrunner.
+		New().
+		Run(cmd.Context(), step.New(
+			step.Exec("go", "mod", "download", "-json", spnModulePath+"@"+version),
+			step.Workdir(dir),
+			step.Stdout(out),
+		)); err != nil {
+		return "", err
+	}
+
+	var module struct {
+		Dir string
+	}
+	if err := json.NewDecoder(out).Decode(&module); err != nil {
+		return "", err
+	}
+
+	if err := copy.Copy(module.Dir, path, copy.Options{AddPermission: 0200}); err != nil {
+		os.RemoveAll(path)
+		return "", err
+	}
+
+	return path, nil
+}
+
+// spnVersion returns the version of the SPN module that Ignite CLI is built with.
+func spnVersion() (string, error) {
+	var deps []*debug.Module
+	if info, ok := debug.ReadBuildInfo(); ok {
+		deps = info.Deps
+	}
+	return spnModuleVersion(deps)
+}
+
+// spnModuleVersion returns the version of the SPN module in deps, the version of its
+// replacement is returned when the module is replaced.
+func spnModuleVersion(deps []*debug.Module) (string, error) {
+	for _, dep := range deps {
+		if dep.Path != spnModulePath {
+			continue
+		}
+		version := dep.Version
+		if dep.Replace != nil {
+			// modules replaced by a local dir have no version.
+			version = dep.Replace.Version
+		}
+		if version != "" {
+			return version, nil
+		}
+	}
+
+	return "", fmt.Errorf("cannot determine the SPN version, use --%s or --%s", flagSPNVersion, flagPath)
+}
+
+// devnetStatePath returns the path of the state file of the devnet.
+func devnetStatePath() (string, error) {
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, devnetDir, devnetStateFile), nil
+}
+
+// saveDevnetState saves the state of the running devnet.
+func saveDevnetState(state devnetState) error {
+	path, err := devnetStatePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// removeDevnetState removes the state of the devnet when it stops.
+func removeDevnetState() {
+	if path, err := devnetStatePath(); err == nil {
+		os.Remove(path)
+	}
+}
+
+// runningDevnet returns the state of the running devnet. the state of a devnet
+// whose process doesn't exist anymore is ignored.
+func runningDevnet() (devnetState, bool) {
+	path, err := devnetStatePath()
+	if err != nil {
+		return devnetState{}, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return devnetState{}, false
+	}
+
+	var state devnetState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return devnetState{}, false
+	}
+
+	process, err := os.FindProcess(state.PID)
+	if err != nil || process.Signal(syscall.Signal(0)) != nil {
+		return devnetState{}, false
+	}
+
+	return state, true
+}
+
+// printDevnetSummary prints the addresses of the devnet and its funded accounts.
+func printDevnetSummary(session cliui.Session, state devnetState, accounts []chainconfig.Account, roles []string) error {
+	type accountResult struct {
+		Role    string `json:"role"`
+		Name    string `json:"name"`
+		Address string `json:"address"`
+		Coins   string `json:"coins"`
+	}
+
+	result := struct {
+		NodeAddress   string          `json:"node_address"`
+		FaucetAddress string          `json:"faucet_address"`
+		Accounts      []accountResult `json:"accounts"`
+	}{
+		NodeAddress:   state.NodeAddress,
+		FaucetAddress: state.FaucetAddress,
+	}
+
+	var entries [][]string
+	for i, account := range accounts {
+		result.Accounts = append(result.Accounts, accountResult{
+			Role:    roles[i],
+			Name:    account.Name,
+			Address: account.Address,
+			Coins:   account.Coins[0],
+		})
+		entries = append(entries, []string{roles[i], account.Name, account.Address, account.Coins[0]})
+	}
+
+	return session.PrintResult(result, func() error {
+		if err := session.Printf("%s SPN devnet is running\n\n", icons.OK); err != nil {
+			return err
+		}
+		if err := session.Printf("🌍 Tendermint node: %s\n🌍 Token faucet: %s\n\n", state.NodeAddress, state.FaucetAddress); err != nil {
+			return err
+		}
+		if err := session.PrintTable(devnetAccountsHeader, entries...); err != nil {
+			return err
+		}
+		return session.Println("\nNetwork commands use the devnet while it runs. Press Ctrl+C to stop it.")
+	})
+}
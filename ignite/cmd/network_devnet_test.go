@@ -0,0 +1,140 @@
+package ignitecmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPNModuleVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		deps    []*debug.Module
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "module",
+			deps: []*debug.Module{
+				{Path: "github.com/cosmos/cosmos-sdk", Version: "v0.45.4"},
+				{Path: spnModulePath, Version: "v0.2.1"},
+			},
+			want: "v0.2.1",
+		},
+		{
+			name: "replaced module",
+			deps: []*debug.Module{
+				{
+					Path:    spnModulePath,
+					Version: "v0.2.1",
+					Replace: &debug.Module{Path: spnModulePath, Version: "v0.2.2-0.20220601"},
+				},
+			},
+			want: "v0.2.2-0.20220601",
+		},
+		{
+			name: "module replaced by a local dir",
+			deps: []*debug.Module{
+				{
+					Path:    spnModulePath,
+					Version: "v0.2.1",
+					Replace: &debug.Module{Path: "../spn"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "no module",
+			deps:    []*debug.Module{{Path: "github.com/cosmos/cosmos-sdk", Version: "v0.45.4"}},
+			wantErr: true,
+		},
+		{
+			name:    "no build info",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := spnModuleVersion(tt.deps)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, version)
+		})
+	}
+}
+
+func TestDownloadSPNDownloadedVersion(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "spn@v0.2.1")
+	require.NoError(t, os.Mkdir(path, 0755))
+
+	cmd := NewNetworkDevnet()
+	require.NoError(t, cmd.Flags().Set(flagSPNVersion, "v0.2.1"))
+
+	// the source of a downloaded version is used without downloading it again.
+	got, err := downloadSPN(cmd, dir)
+	require.NoError(t, err)
+	require.Equal(t, path, got)
+}
+
+func TestRunningDevnet(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	path, err := devnetStatePath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+
+	t.Run("no state", func(t *testing.T) {
+		_, ok := runningDevnet()
+		require.False(t, ok)
+	})
+
+	t.Run("running process", func(t *testing.T) {
+		state := devnetState{
+			PID:           os.Getpid(),
+			NodeAddress:   "http://0.0.0.0:26657",
+			FaucetAddress: "http://0.0.0.0:4500",
+		}
+		require.NoError(t, saveDevnetState(state))
+		defer removeDevnetState()
+
+		got, ok := runningDevnet()
+		require.True(t, ok)
+		require.Equal(t, state, got)
+	})
+
+	t.Run("stopped process", func(t *testing.T) {
+		process := exec.Command("go", "version")
+		require.NoError(t, process.Run())
+
+		require.NoError(t, saveDevnetState(devnetState{PID: process.Process.Pid}))
+		defer removeDevnetState()
+
+		_, ok := runningDevnet()
+		require.False(t, ok)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0644))
+		defer removeDevnetState()
+
+		_, ok := runningDevnet()
+		require.False(t, ok)
+	})
+
+	t.Run("removed state", func(t *testing.T) {
+		require.NoError(t, saveDevnetState(devnetState{PID: os.Getpid()}))
+		removeDevnetState()
+
+		_, ok := runningDevnet()
+		require.False(t, ok)
+	})
+}
@@ -1,6 +1,16 @@
 package ignitecmd
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+)
+
+const (
+	flagDryRun = "dry-run"
+)
 
 // NewNetworkRequest creates a new approval request command that holds some other
 // sub commands related to handle request for a chain.
@@ -16,7 +26,89 @@ func NewNetworkRequest() *cobra.Command {
 		NewNetworkRequestApprove(),
 		NewNetworkRequestReject(),
 		NewNetworkRequestVerify(),
+		NewNetworkRequestDiff(),
+		NewNetworkRequestAutoReview(),
+		NewNetworkRequestAddAccount(),
+		NewNetworkRequestAddVestingAccount(),
+		NewNetworkRequestRemoveAccount(),
+		NewNetworkRequestRemoveValidator(),
 	)
 
 	return c
 }
+
+// newNetworkRequestSend creates a command that sends a request with the content
+// returned by newContent for the launch of its first argument.
+func newNetworkRequestSend(
+	use, short string,
+	args cobra.PositionalArgs,
+	newContent func(cmd *cobra.Command, launchID uint64, args []string) (launchtypes.RequestContent, error),
+) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session := newSession(cmd)
+			defer session.Cleanup()
+
+			nb, launchID, err := networkChainLaunch(cmd, args, session)
+			if err != nil {
+				return err
+			}
+			content, err := newContent(cmd, launchID, args)
+			if err != nil {
+				return err
+			}
+			return networkRequestSend(cmd, session, nb, launchID, content)
+		},
+	}
+
+	c.Flags().Bool(flagNoVerification, false, "send the request without verifying it against the genesis information")
+	c.Flags().Bool(flagDryRun, false, "print the unsigned transaction of the request without broadcasting it")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+// networkRequestSend verifies the request content against the genesis information of the launch
+// and sends the request, the unsigned transaction is printed instead with --dry-run.
+func networkRequestSend(
+	cmd *cobra.Command,
+	session cliui.Session,
+	nb NetworkBuilder,
+	launchID uint64,
+	content launchtypes.RequestContent,
+) error {
+	var (
+		noVerification, _ = cmd.Flags().GetBool(flagNoVerification)
+		dryRun, _         = cmd.Flags().GetBool(flagDryRun)
+	)
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	if !noVerification {
+		if err := n.VerifyRequestContent(cmd.Context(), launchID, content); err != nil {
+			return err
+		}
+		session.StopSpinner()
+		if err := session.Printf("%s Request verified against the genesis information\n", icons.OK); err != nil {
+			return err
+		}
+	}
+
+	if dryRun {
+		tx, err := n.RequestTx(launchID, content)
+		if err != nil {
+			return err
+		}
+		session.StopSpinner()
+		return session.Println(string(tx))
+	}
+
+	_, err = n.SendRequest(launchID, content)
+	return err
+}
@@ -0,0 +1,23 @@
+package ignitecmd
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// NewNetworkRequestAddAccount creates a new command to send a request to add a genesis account to a chain.
+func NewNetworkRequestAddAccount() *cobra.Command {
+	return newNetworkRequestSend(
+		"add-account [launch-id] [address] [coins]",
+		"Send a request to add a genesis account",
+		cobra.ExactArgs(3),
+		func(_ *cobra.Command, launchID uint64, args []string) (launchtypes.RequestContent, error) {
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return launchtypes.RequestContent{}, err
+			}
+			return launchtypes.NewGenesisAccount(launchID, args[1], coins), nil
+		},
+	)
+}
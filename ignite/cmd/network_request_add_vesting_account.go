@@ -0,0 +1,54 @@
+package ignitecmd
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	flagTotalBalance = "total-balance"
+)
+
+// NewNetworkRequestAddVestingAccount creates a new command to send a request to add a genesis
+// vesting account to a chain.
+func NewNetworkRequestAddVestingAccount() *cobra.Command {
+	c := newNetworkRequestSend(
+		"add-vesting-account [launch-id] [address] [vesting-coins] [end-time]",
+		"Send a request to add a genesis vesting account",
+		cobra.ExactArgs(4),
+		func(cmd *cobra.Command, launchID uint64, args []string) (launchtypes.RequestContent, error) {
+			vesting, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return launchtypes.RequestContent{}, err
+			}
+
+			totalBalance := vesting
+			if balance, _ := cmd.Flags().GetString(flagTotalBalance); balance != "" {
+				if totalBalance, err = sdk.ParseCoinsNormalized(balance); err != nil {
+					return launchtypes.RequestContent{}, err
+				}
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return launchtypes.RequestContent{}, errors.Wrap(err, "the end time must have the RFC 3339 format")
+			}
+
+			return launchtypes.NewVestingAccount(
+				launchID,
+				args[1],
+				*launchtypes.NewDelayedVesting(totalBalance, vesting, endTime.Unix()),
+			), nil
+		},
+	)
+	c.Long = `Send a request to add a genesis vesting account with a delayed vesting of the vesting coins
+until the end time, in the RFC 3339 format (2006-01-02T15:04:05Z).
+
+The total balance of the account is the vesting coins unless --total-balance is provided.`
+	c.Flags().String(flagTotalBalance, "", "Total balance of the account including the vesting coins")
+	return c
+}
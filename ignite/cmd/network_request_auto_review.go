@@ -0,0 +1,279 @@
+package ignitecmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/ctxticker"
+	"github.com/ignite-hq/cli/ignite/services/network"
+	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+const (
+	flagLaunchID = "launch-id"
+	flagPolicy   = "policy"
+	flagInterval = "interval"
+	flagOnce     = "once"
+)
+
+// NewNetworkRequestAutoReview creates a new command to review the requests of a chain
+// automatically with a policy.
+func NewNetworkRequestAutoReview() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "auto-review",
+		Short: "Approve or reject the requests of a chain automatically with a policy",
+		Long: `Watch the pending requests of a chain and approve or reject them with a policy.
+
+Requests are verified and the requests that follow the policy are simulated in the genesis
+like "request verify" does before they are approved. Reviewals are submitted in batches and
+the reason of each reviewal is printed. Removal requests are left for a manual review.
+
+The policy is a YAML file with the following rules, all of them are optional:
+
+  max_self_delegation: 100000000stake # maximum self delegation of the validators
+  allowed_accounts:                   # the only accounts that can be added to the genesis
+    - spn1...
+  require_peer_reachable: true        # reject the validators whose peers cannot be verified
+  max_validators: 10                  # maximum number of genesis validators
+`,
+		Args: cobra.NoArgs,
+		RunE: networkRequestAutoReviewHandler,
+	}
+
+	flagSetClearCache(c)
+	c.Flags().Uint64(flagLaunchID, 0, "Launch ID of the chain")
+	c.Flags().String(flagPolicy, "", "Path of the review policy")
+	c.Flags().Duration(flagInterval, 30*time.Second, "Interval to check for new requests")
+	c.Flags().Bool(flagOnce, false, "Review the pending requests once and exit")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.MarkFlagRequired(flagLaunchID)
+	c.MarkFlagRequired(flagPolicy)
+
+	return c
+}
+
+func networkRequestAutoReviewHandler(cmd *cobra.Command, _ []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	var (
+		launchID, _   = cmd.Flags().GetUint64(flagLaunchID)
+		policyPath, _ = cmd.Flags().GetString(flagPolicy)
+		interval, _   = cmd.Flags().GetDuration(flagInterval)
+		once, _       = cmd.Flags().GetBool(flagOnce)
+	)
+
+	policy, err := network.ParseReviewPolicy(policyPath)
+	if err != nil {
+		return err
+	}
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	r := autoReviewer{
+		session:  session,
+		n:        n,
+		policy:   policy,
+		launchID: launchID,
+		reviewed: make(map[uint64]bool),
+		verify: func(ctx context.Context, requestIDs ...uint64) error {
+			return verifyRequest(ctx, cacheStorage, nb, launchID, requestIDs...)
+		},
+	}
+
+	if once {
+		return r.review(cmd.Context())
+	}
+
+	return ctxticker.DoNow(cmd.Context(), interval, func() error {
+		// the reviewer keeps running when SPN cannot be reached for a while.
+		if err := r.review(cmd.Context()); err != nil {
+			session.Printf("%s %s\n", icons.NotOK, err)
+		}
+		return nil
+	})
+}
+
+// autoReviewer reviews the pending requests of a chain with a policy.
+type autoReviewer struct {
+	session  cliui.Session
+	n        network.Network
+	policy   network.ReviewPolicy
+	launchID uint64
+
+	// reviewed keeps the requests that are already reviewed, including the skipped ones
+	// that are left for a manual review.
+	reviewed map[uint64]bool
+
+	// verify simulates the genesis of the chain with the requests.
+	verify func(ctx context.Context, requestIDs ...uint64) error
+}
+
+// review reviews the new pending requests and submits the reviewals.
+func (r autoReviewer) review(ctx context.Context) error {
+	requests, err := r.n.Requests(ctx, r.launchID)
+	if err != nil {
+		return err
+	}
+
+	pendingStatus := launchtypes.Request_Status_name[int32(launchtypes.Request_PENDING)]
+
+	var pending []networktypes.Request
+	for _, request := range requests {
+		if request.Status == pendingStatus && !r.reviewed[request.RequestID] {
+			pending = append(pending, request)
+		}
+	}
+	if len(pending) == 0 {
+		r.session.StopSpinner()
+		return nil
+	}
+
+	validators, err := r.n.GenesisValidators(ctx, r.launchID)
+	if err != nil {
+		return err
+	}
+
+	reviews := network.ReviewRequests(ctx, r.policy, len(validators), pending)
+
+	retried := r.simulate(ctx, reviews)
+
+	// nothing is submitted when the review is interrupted, a rejection cannot be undone.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var reviewals []network.Reviewal
+	for _, review := range reviews {
+		if !review.Skipped {
+			reviewals = append(reviewals, review.Reviewal)
+		}
+	}
+
+	if len(reviewals) > 0 {
+		if err := r.n.SubmitRequest(r.launchID, reviewals...); err != nil {
+			return err
+		}
+	}
+
+	r.session.StopSpinner()
+
+	for _, review := range reviews {
+		if !retried[review.RequestID] {
+			r.reviewed[review.RequestID] = true
+		}
+
+		switch {
+		case review.Skipped:
+			r.session.Printf("%s Request #%d skipped: %s\n", icons.Info, review.RequestID, review.Reason)
+		case review.IsApproved:
+			r.session.Printf("%s Request #%d approved: %s\n", icons.OK, review.RequestID, review.Reason)
+		default:
+			r.session.Printf("%s Request #%d rejected: %s\n", icons.NotOK, review.RequestID, review.Reason)
+		}
+	}
+
+	return nil
+}
+
+// simulate simulates the genesis with the approved requests. when the simulation fails,
+// the requests are simulated one by one to reject the failing ones and the others are
+// simulated together again, they are left for a manual review if they still fail.
+// requests are only rejected when the genesis is invalid with them, the requests whose
+// simulation cannot be run are skipped and returned to be reviewed again.
+func (r autoReviewer) simulate(ctx context.Context, reviews []network.RequestReview) (retried map[uint64]bool) {
+	retried = make(map[uint64]bool)
+
+	approved := func() (indexes []int, ids []uint64) {
+		for i, review := range reviews {
+			if review.IsApproved && !review.Skipped {
+				indexes = append(indexes, i)
+				ids = append(ids, review.RequestID)
+			}
+		}
+		return
+	}
+
+	retry := func(indexes []int, err error) {
+		for _, i := range indexes {
+			reviews[i].Skipped = true
+			reviews[i].Reason = "the genesis simulation cannot be run, retrying later: " + err.Error()
+			retried[reviews[i].RequestID] = true
+		}
+	}
+
+	indexes, ids := approved()
+	if len(ids) == 0 {
+		return
+	}
+	err := r.verify(ctx, ids...)
+	if err == nil {
+		return
+	}
+	if !errors.Is(err, networkchain.ErrInvalidGenesis) || ctx.Err() != nil {
+		retry(indexes, err)
+		return
+	}
+
+	if len(ids) > 1 {
+		for _, i := range indexes {
+			err := r.verify(ctx, reviews[i].RequestID)
+			switch {
+			case err == nil:
+			case !errors.Is(err, networkchain.ErrInvalidGenesis) || ctx.Err() != nil:
+				retry([]int{i}, err)
+			default:
+				reviews[i].Reviewal = network.RejectRequest(reviews[i].RequestID)
+				reviews[i].Reason = "the genesis simulation failed: " + err.Error()
+			}
+		}
+
+		indexes, ids = approved()
+		if len(ids) == 0 {
+			return
+		}
+		if err = r.verify(ctx, ids...); err == nil {
+			return
+		}
+		if !errors.Is(err, networkchain.ErrInvalidGenesis) || ctx.Err() != nil {
+			retry(indexes, err)
+			return
+		}
+	}
+
+	for _, i := range indexes {
+		reason := "the genesis simulation with the other approved requests failed"
+		if len(ids) == 1 {
+			// a single request is rejected when it cannot be added to the genesis.
+			reviews[i].Reviewal = network.RejectRequest(reviews[i].RequestID)
+			reason = "the genesis simulation failed: " + err.Error()
+		} else {
+			reviews[i].Skipped = true
+		}
+		reviews[i].Reason = reason
+	}
+
+	return
+}
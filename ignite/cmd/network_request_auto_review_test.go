@@ -0,0 +1,146 @@
+package ignitecmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/services/network"
+	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+)
+
+func TestAutoReviewerSimulate(t *testing.T) {
+	var (
+		errInvalid = fmt.Errorf("%w: gentx of request #2 is invalid", networkchain.ErrInvalidGenesis)
+		errBuild   = errors.New("cannot build the chain")
+	)
+
+	approved := func(ids ...uint64) []network.RequestReview {
+		reviews := make([]network.RequestReview, 0, len(ids))
+		for _, id := range ids {
+			reviews = append(reviews, network.RequestReview{Reviewal: network.ApproveRequest(id)})
+		}
+		return reviews
+	}
+
+	// verifyFailing fails the simulations that include one of the failing requests with err.
+	verifyFailing := func(err error, failing ...uint64) func(context.Context, ...uint64) error {
+		return func(_ context.Context, ids ...uint64) error {
+			for _, id := range ids {
+				for _, f := range failing {
+					if id == f {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+	}
+
+	type result struct {
+		approved, skipped bool
+	}
+
+	tests := []struct {
+		name        string
+		verify      func(context.Context, ...uint64) error
+		cancel      bool
+		want        map[uint64]result
+		wantRetried []uint64
+	}{
+		{
+			name:   "valid requests",
+			verify: verifyFailing(nil),
+			want: map[uint64]result{
+				1: {approved: true},
+				2: {approved: true},
+			},
+		},
+		{
+			name:   "invalid request",
+			verify: verifyFailing(errInvalid, 2),
+			want: map[uint64]result{
+				1: {approved: true},
+				2: {approved: false},
+			},
+		},
+		{
+			name: "requests invalid together",
+			verify: func(_ context.Context, ids ...uint64) error {
+				if len(ids) > 1 {
+					return errInvalid
+				}
+				return nil
+			},
+			want: map[uint64]result{
+				1: {approved: true, skipped: true},
+				2: {approved: true, skipped: true},
+			},
+		},
+		{
+			name:   "simulation not run",
+			verify: verifyFailing(errBuild, 1, 2),
+			want: map[uint64]result{
+				1: {approved: true, skipped: true},
+				2: {approved: true, skipped: true},
+			},
+			wantRetried: []uint64{1, 2},
+		},
+		{
+			name: "simulation of a request not run",
+			verify: func(ctx context.Context, ids ...uint64) error {
+				if len(ids) > 1 {
+					return errInvalid
+				}
+				return verifyFailing(errBuild, 2)(ctx, ids...)
+			},
+			want: map[uint64]result{
+				1: {approved: true},
+				2: {approved: true, skipped: true},
+			},
+			wantRetried: []uint64{2},
+		},
+		{
+			name:   "interrupted simulation",
+			verify: verifyFailing(errInvalid, 1, 2),
+			cancel: true,
+			want: map[uint64]result{
+				1: {approved: true, skipped: true},
+				2: {approved: true, skipped: true},
+			},
+			wantRetried: []uint64{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			var (
+				r       = autoReviewer{verify: tt.verify}
+				reviews = approved(1, 2)
+			)
+
+			retried := r.simulate(ctx, reviews)
+
+			for _, review := range reviews {
+				require.Equal(t, tt.want[review.RequestID], result{
+					approved: review.IsApproved,
+					skipped:  review.Skipped,
+				}, "request #%d: %s", review.RequestID, review.Reason)
+			}
+
+			require.Len(t, retried, len(tt.wantRetried))
+			for _, id := range tt.wantRetried {
+				require.True(t, retried[id])
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/pkg/numbers"
+	"github.com/ignite-hq/cli/ignite/services/network"
+)
+
+var diffKindSymbols = map[cosmosutil.DiffKind]string{
+	cosmosutil.DiffAdded:   "+",
+	cosmosutil.DiffRemoved: "-",
+	cosmosutil.DiffChanged: "~",
+}
+
+// NewNetworkRequestDiff creates a new command to preview the genesis changes of requests.
+func NewNetworkRequestDiff() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "diff [launch-id] [number<,...>]",
+		Short: "Show the changes of the chain genesis from the requests",
+		Long: `Build the genesis of the chain before and after applying the requests and show the
+accounts and balances, vesting schedules, validators with their share of the voting power
+and params that are added, removed or changed by the requests.`,
+		RunE: networkRequestDiffHandler,
+		Args: cobra.ExactArgs(2),
+	}
+
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+func networkRequestDiffHandler(cmd *cobra.Command, args []string) error {
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	// parse launch ID
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+
+	// get the list of request ids
+	ids, err := numbers.ParseList(args[1])
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	homeDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(homeDir)
+
+	c, genesisInformation, requests, err := requestsChain(cmd.Context(), nb, homeDir, launchID, ids...)
+	if err != nil {
+		return err
+	}
+
+	diff, err := c.DiffRequests(cmd.Context(), cacheStorage, genesisInformation, requests)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.PrintResult(diff, func() error {
+		if diff.IsEmpty() {
+			return session.Printf("%s Request(s) %s don't change the genesis\n", icons.Info, numbers.List(ids, "#"))
+		}
+		return printGenesisDiff(session, diff)
+	})
+}
+
+// printGenesisDiff prints the sections of the genesis diff that have changes.
+func printGenesisDiff(session cliui.Session, diff cosmosutil.GenesisDiff) error {
+	if len(diff.Balances) > 0 {
+		var entries [][]string
+		for _, d := range diff.Balances {
+			entries = append(entries, []string{
+				diffKindSymbols[d.Kind],
+				d.Address,
+				d.Before.String(),
+				d.After.String(),
+			})
+		}
+		if err := printDiffSection(session, "Accounts", []string{"", "Address", "Before", "After"}, entries); err != nil {
+			return err
+		}
+	}
+
+	if len(diff.VestingAccounts) > 0 {
+		var entries [][]string
+		for _, d := range diff.VestingAccounts {
+			entries = append(entries, []string{
+				diffKindSymbols[d.Kind],
+				d.Address,
+				vestingScheduleString(d.Before),
+				vestingScheduleString(d.After),
+			})
+		}
+		if err := printDiffSection(session, "Vesting accounts", []string{"", "Address", "Before", "After"}, entries); err != nil {
+			return err
+		}
+	}
+
+	if len(diff.Validators) > 0 {
+		var entries [][]string
+		for _, d := range diff.Validators {
+			entries = append(entries, []string{
+				diffKindSymbols[d.Kind],
+				d.Address,
+				d.Moniker,
+				d.SelfDelegation.String(),
+				fmt.Sprintf("%.2f%%", d.ShareBefore),
+				fmt.Sprintf("%.2f%%", d.ShareAfter),
+			})
+		}
+		header := []string{"", "Validator", "Moniker", "Self Delegation", "Share Before", "Share After"}
+		if err := printDiffSection(session, "Validators", header, entries); err != nil {
+			return err
+		}
+	}
+
+	if len(diff.Params) > 0 {
+		var entries [][]string
+		for _, d := range diff.Params {
+			entries = append(entries, []string{diffKindSymbols[d.Kind], d.Key, d.Before, d.After})
+		}
+		if err := printDiffSection(session, "Params", []string{"", "Param", "Before", "After"}, entries); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printDiffSection(session cliui.Session, title string, header []string, entries [][]string) error {
+	if err := session.Printf("%s %s\n", icons.Bullet, title); err != nil {
+		return err
+	}
+	if err := session.PrintTable(header, entries...); err != nil {
+		return err
+	}
+	return session.Println()
+}
+
+// vestingScheduleString returns a readable vesting schedule.
+func vestingScheduleString(schedule *cosmosutil.VestingSchedule) string {
+	if schedule == nil {
+		return ""
+	}
+	end := time.Unix(schedule.EndTime, 0).UTC().Format(time.RFC3339)
+	if schedule.StartTime == 0 {
+		return fmt.Sprintf("%s until %s", schedule.OriginalVesting, end)
+	}
+	start := time.Unix(schedule.StartTime, 0).UTC().Format(time.RFC3339)
+	return fmt.Sprintf("%s from %s until %s", schedule.OriginalVesting, start, end)
+}
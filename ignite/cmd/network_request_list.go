@@ -30,7 +30,7 @@ func NewNetworkRequestList() *cobra.Command {
 }
 
 func networkRequestListHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -61,12 +61,21 @@ func networkRequestListHandler(cmd *cobra.Command, args []string) error {
 	return renderRequestSummaries(requests, session, addressPrefix)
 }
 
+// requestSummary is a summarized request printed in JSON output.
+type requestSummary struct {
+	ID      uint64 `json:"id"`
+	Status  string `json:"status"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
 // renderRequestSummaries writes into the provided out, the list of summarized requests
 func renderRequestSummaries(
 	requests []networktypes.Request,
 	session cliui.Session,
 	addressPrefix string,
 ) error {
+	summaries := make([]requestSummary, 0)
 	requestEntries := make([][]string, 0)
 	for _, request := range requests {
 		var (
@@ -158,6 +167,12 @@ func renderRequestSummaries(
 			content = address
 		}
 
+		summaries = append(summaries, requestSummary{
+			ID:      request.RequestID,
+			Status:  request.Status,
+			Type:    requestType,
+			Content: content,
+		})
 		requestEntries = append(requestEntries, []string{
 			id,
 			request.Status,
@@ -165,5 +180,7 @@ func renderRequestSummaries(
 			content,
 		})
 	}
-	return session.PrintTable(requestSummaryHeader, requestEntries...)
+	return session.PrintResult(summaries, func() error {
+		return session.PrintTable(requestSummaryHeader, requestEntries...)
+	})
 }
@@ -0,0 +1,18 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// NewNetworkRequestRemoveAccount creates a new command to send a request to remove a genesis account from a chain.
+func NewNetworkRequestRemoveAccount() *cobra.Command {
+	return newNetworkRequestSend(
+		"remove-account [launch-id] [address]",
+		"Send a request to remove a genesis account",
+		cobra.ExactArgs(2),
+		func(_ *cobra.Command, _ uint64, args []string) (launchtypes.RequestContent, error) {
+			return launchtypes.NewAccountRemoval(args[1]), nil
+		},
+	)
+}
@@ -0,0 +1,18 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// NewNetworkRequestRemoveValidator creates a new command to send a request to remove a genesis validator from a chain.
+func NewNetworkRequestRemoveValidator() *cobra.Command {
+	return newNetworkRequestSend(
+		"remove-validator [launch-id] [address]",
+		"Send a request to remove a genesis validator",
+		cobra.ExactArgs(2),
+		func(_ *cobra.Command, _ uint64, args []string) (launchtypes.RequestContent, error) {
+			return launchtypes.NewValidatorRemoval(args[1]), nil
+		},
+	)
+}
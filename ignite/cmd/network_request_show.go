@@ -6,7 +6,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/yaml"
 	"github.com/ignite-hq/cli/ignite/services/network"
 )
@@ -24,7 +23,7 @@ func NewNetworkRequestShow() *cobra.Command {
 }
 
 func networkRequestShowHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -54,17 +53,19 @@ func networkRequestShowHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// convert the request object to YAML to be more readable
-	// and convert the byte array fields to string.
-	requestYaml, err := yaml.Marshal(cmd.Context(), request,
-		"$.Content.content.genesisValidator.genTx",
-		"$.Content.content.genesisValidator.consPubKey",
-	)
-	if err != nil {
-		return err
-	}
-
 	session.StopSpinner()
 
-	return session.Println(requestYaml)
+	return session.PrintResult(request, func() error {
+		// convert the request object to YAML to be more readable
+		// and convert the byte array fields to string.
+		requestYaml, err := yaml.Marshal(cmd.Context(), request,
+			"$.Content.content.genesisValidator.genTx",
+			"$.Content.content.genesisValidator.consPubKey",
+		)
+		if err != nil {
+			return err
+		}
+
+		return session.Println(requestYaml)
+	})
 }
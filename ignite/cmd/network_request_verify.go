@@ -2,9 +2,11 @@ package ignitecmd
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
@@ -13,18 +15,26 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/numbers"
 	"github.com/ignite-hq/cli/ignite/services/network"
 	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
 )
 
+const flagCheckPeers = "check-peers"
+
 // NewNetworkRequestVerify verify the request and simulate the chain.
 func NewNetworkRequestVerify() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "verify [launch-id] [number<,...>]",
 		Short: "Verify the request and simulate the chain genesis from them",
-		RunE:  networkRequestVerifyHandler,
-		Args:  cobra.ExactArgs(2),
+		Long: `Verify the requests, including the signatures of the gentxs of the validators for the
+chain ID, and simulate the chain genesis from them.
+
+Use --check-peers to also dial the peers of the validators and check their node ID.`,
+		RunE: networkRequestVerifyHandler,
+		Args: cobra.ExactArgs(2),
 	}
 
 	flagSetClearCache(c)
+	c.Flags().Bool(flagCheckPeers, false, "Dial the peers of the validators and check their node ID")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -32,7 +42,7 @@ func NewNetworkRequestVerify() *cobra.Command {
 }
 
 func networkRequestVerifyHandler(cmd *cobra.Command, args []string) error {
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -58,6 +68,12 @@ func networkRequestVerifyHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	// verify the requests
+	if checkPeers, _ := cmd.Flags().GetBool(flagCheckPeers); checkPeers {
+		if err := verifyRequestPeers(cmd.Context(), session, nb, launchID, ids...); err != nil {
+			return err
+		}
+	}
+
 	if err := verifyRequest(cmd.Context(), cacheStorage, nb, launchID, ids...); err != nil {
 		session.Printf("%s Request(s) %s not valid\n", icons.NotOK, numbers.List(ids, "#"))
 		return err
@@ -66,11 +82,10 @@ func networkRequestVerifyHandler(cmd *cobra.Command, args []string) error {
 	return session.Printf("%s Request(s) %s verified\n", icons.OK, numbers.List(ids, "#"))
 }
 
-// verifyRequest initialize the chain from the launch ID in a temporary directory
-// and simulate the launch of the chain from genesis with the request IDs
-func verifyRequest(
+// verifyRequestPeers dials the peers of the validator requests and checks their node ID
+func verifyRequestPeers(
 	ctx context.Context,
-	cacheStorage cache.Storage,
+	session cliui.Session,
 	nb NetworkBuilder,
 	launchID uint64,
 	requestIDs ...uint64,
@@ -80,42 +95,115 @@ func verifyRequest(
 		return err
 	}
 
-	// initialize the chain with a temporary dir
-	chainLaunch, err := n.ChainLaunch(ctx, launchID)
+	requests, err := n.RequestFromIDs(ctx, launchID, requestIDs...)
 	if err != nil {
 		return err
 	}
 
+	session.StartSpinner("Checking the peers of the validators...")
+
+	type peerCheck struct {
+		requestID uint64
+		peerID    string
+		err       error
+	}
+
+	var checks []peerCheck
+	for _, request := range requests {
+		req, ok := request.Content.Content.(*launchtypes.RequestContent_GenesisValidator)
+		if !ok {
+			continue
+		}
+		checks = append(checks, peerCheck{
+			requestID: request.RequestID,
+			peerID:    req.GenesisValidator.Peer.Id,
+			err:       network.VerifyPeer(ctx, req.GenesisValidator.Peer),
+		})
+	}
+
+	session.StopSpinner()
+
+	var failed []uint64
+	for _, check := range checks {
+		if check.err != nil {
+			failed = append(failed, check.requestID)
+			session.Printf("%s Request #%d peer not verified: %s\n", icons.NotOK, check.requestID, check.err)
+			continue
+		}
+		session.Printf("%s Request #%d peer %s verified\n", icons.OK, check.requestID, check.peerID)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("the peers of the request(s) %s cannot be verified", numbers.List(failed, "#"))
+	}
+	return nil
+}
+
+// verifyRequest initialize the chain from the launch ID in a temporary directory
+// and simulate the launch of the chain from genesis with the request IDs
+func verifyRequest(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	nb NetworkBuilder,
+	launchID uint64,
+	requestIDs ...uint64,
+) error {
 	homeDir, err := os.MkdirTemp("", "")
 	if err != nil {
 		return err
 	}
 	defer os.RemoveAll(homeDir)
 
+	c, genesisInformation, requests, err := requestsChain(ctx, nb, homeDir, launchID, requestIDs...)
+	if err != nil {
+		return err
+	}
+
+	return c.SimulateRequests(
+		ctx,
+		cacheStorage,
+		genesisInformation,
+		requests,
+	)
+}
+
+// requestsChain creates the chain from the launch ID in homeDir and fetches the current
+// genesis information and the requests with the request IDs for the chain
+func requestsChain(
+	ctx context.Context,
+	nb NetworkBuilder,
+	homeDir string,
+	launchID uint64,
+	requestIDs ...uint64,
+) (*networkchain.Chain, networktypes.GenesisInformation, []networktypes.Request, error) {
+	n, err := nb.Network()
+	if err != nil {
+		return nil, networktypes.GenesisInformation{}, nil, err
+	}
+
+	chainLaunch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return nil, networktypes.GenesisInformation{}, nil, err
+	}
+
 	c, err := nb.Chain(
 		networkchain.SourceLaunch(chainLaunch),
 		networkchain.WithHome(homeDir),
 		networkchain.WithKeyringBackend(chaincmd.KeyringBackendTest),
 	)
 	if err != nil {
-		return err
+		return nil, networktypes.GenesisInformation{}, nil, err
 	}
 
-	// fetch the current genesis information and the requests for the chain for simulation
 	genesisInformation, err := n.GenesisInformation(ctx, launchID)
 	if err != nil {
-		return err
+		return nil, networktypes.GenesisInformation{}, nil, err
 	}
 
 	requests, err := n.RequestFromIDs(ctx, launchID, requestIDs...)
 	if err != nil {
-		return err
+		return nil, networktypes.GenesisInformation{}, nil, err
 	}
 
-	return c.SimulateRequests(
-		ctx,
-		cacheStorage,
-		genesisInformation,
-		requests,
-	)
+	return c, genesisInformation, requests, nil
 }
@@ -18,6 +18,10 @@ func NewRelayer() *cobra.Command {
 	c.AddCommand(
 		NewRelayerConfigure(),
 		NewRelayerConnect(),
+		NewRelayerStatus(),
+		NewRelayerFlush(),
+		NewRelayerExport(),
+		NewRelayerImport(),
 	)
 
 	return c
@@ -19,6 +19,8 @@ const (
 	flagTargetAccount       = "target-account"
 	flagSourceRPC           = "source-rpc"
 	flagTargetRPC           = "target-rpc"
+	flagSourceGRPC          = "source-grpc"
+	flagTargetGRPC          = "target-grpc"
 	flagSourceFaucet        = "source-faucet"
 	flagTargetFaucet        = "target-faucet"
 	flagSourcePort          = "source-port"
@@ -64,6 +66,8 @@ func NewRelayerConfigure() *cobra.Command {
 	c.Flags().BoolP(flagAdvanced, "a", false, "Advanced configuration options for custom IBC modules")
 	c.Flags().String(flagSourceRPC, "", "RPC address of the source chain")
 	c.Flags().String(flagTargetRPC, "", "RPC address of the target chain")
+	c.Flags().String(flagSourceGRPC, "", "gRPC address of the source chain, required to export the chain to Hermes")
+	c.Flags().String(flagTargetGRPC, "", "gRPC address of the target chain, required to export the chain to Hermes")
 	c.Flags().String(flagSourceFaucet, "", "Faucet address of the source chain")
 	c.Flags().String(flagTargetFaucet, "", "Faucet address of the target chain")
 	c.Flags().String(flagSourcePort, "", "IBC port ID on the source chain")
@@ -92,7 +96,7 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		err = handleRelayerAccountErr(err)
 	}()
 
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	ca, err := cosmosaccount.New(
@@ -116,6 +120,8 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		targetAccount       string
 		sourceRPCAddress    string
 		targetRPCAddress    string
+		sourceGRPCAddress   string
+		targetGRPCAddress   string
 		sourceFaucetAddress string
 		targetFaucetAddress string
 		sourceGasPrice      string
@@ -247,6 +253,10 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 	if err != nil {
 		return err
 	}
+	sourceGRPCAddress, err = cmd.Flags().GetString(flagSourceGRPC)
+	if err != nil {
+		return err
+	}
 	sourceFaucetAddress, err = cmd.Flags().GetString(flagSourceFaucet)
 	if err != nil {
 		return err
@@ -255,6 +265,10 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 	if err != nil {
 		return err
 	}
+	targetGRPCAddress, err = cmd.Flags().GetString(flagTargetGRPC)
+	if err != nil {
+		return err
+	}
 	targetFaucetAddress, err = cmd.Flags().GetString(flagTargetFaucet)
 	if err != nil {
 		return err
@@ -390,6 +404,7 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		relayerSource,
 		sourceAccount,
 		sourceRPCAddress,
+		sourceGRPCAddress,
 		sourceFaucetAddress,
 		sourceGasPrice,
 		sourceGasLimit,
@@ -407,6 +422,7 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		relayerTarget,
 		targetAccount,
 		targetRPCAddress,
+		targetGRPCAddress,
 		targetFaucetAddress,
 		targetGasPrice,
 		targetGasLimit,
@@ -454,6 +470,7 @@ func initChain(
 	name,
 	accountName,
 	rpcAddr,
+	grpcAddr,
 	faucetAddr,
 	gasPrice string,
 	gasLimit int64,
@@ -468,6 +485,7 @@ func initChain(
 		accountName,
 		rpcAddr,
 		relayer.WithFaucet(faucetAddr),
+		relayer.WithGRPCAddress(grpcAddr),
 		relayer.WithGasPrice(gasPrice),
 		relayer.WithGasLimit(gasLimit),
 		relayer.WithAddressPrefix(addressPrefix),
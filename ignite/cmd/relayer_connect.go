@@ -7,7 +7,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite-hq/cli/ignite/pkg/relayer"
 )
@@ -32,7 +31,7 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		err = handleRelayerAccountErr(err)
 	}()
 
-	session := cliui.New()
+	session := newSession(cmd)
 	defer session.Cleanup()
 
 	ca, err := cosmosaccount.New(
@@ -49,7 +48,7 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 	var (
 		use []string
 		ids = args
-		r   = relayer.New(ca)
+		r   = relayer.New(ca, relayer.CollectEvents(session.EventBus()))
 	)
 
 	all, err := r.ListPaths(cmd.Context())
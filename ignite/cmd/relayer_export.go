@@ -0,0 +1,76 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+)
+
+const (
+	flagFormat = "format"
+)
+
+// NewRelayerExport returns a new relayer export command to convert the relayer config
+// to the config of another relayer.
+func NewRelayerExport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export",
+		Short: "Export the chains and paths of the relayer to a Hermes or rly config",
+		Long: `Export the chains and paths of the relayer to a Hermes or rly config.
+
+Accounts are referenced by their names in the keyring, keys are never exported.
+`,
+		Args: cobra.NoArgs,
+		RunE: relayerExportHandler,
+	}
+
+	c.Flags().String(flagFormat, string(relayer.FormatHermes), fmt.Sprintf("config format %v", relayer.Formats))
+	c.Flags().StringP(flagOutput, "o", "", "config output path (defaults to stdout)")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerExportHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		formatName, _ = cmd.Flags().GetString(flagFormat)
+		output, _     = cmd.Flags().GetString(flagOutput)
+	)
+
+	format, err := relayer.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	r := relayer.New(ca)
+
+	data, err := r.Export(cmd.Context(), format, string(getKeyringBackend(cmd)))
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		return session.Print(string(data))
+	}
+
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return err
+	}
+
+	return session.Printf("%s Relayer config exported to %s\n", icons.OK, output)
+}
@@ -0,0 +1,64 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+)
+
+// NewRelayerFlush returns a new relayer flush command to relay the pending packets
+// and acks of a path once.
+func NewRelayerFlush() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "flush [path]",
+		Short: "Relay the pending packets and acks of a path once and exit",
+		Args:  cobra.ExactArgs(1),
+		RunE:  relayerFlushHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerFlushHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		id = args[0]
+		r  = relayer.New(ca)
+	)
+
+	session.StartSpinner("Relaying pending packets and acks...")
+
+	if err := r.Flush(cmd.Context(), id); err != nil {
+		return err
+	}
+
+	statuses, err := r.Status(cmd.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if err := session.Printf("%s Pending packets and acks of %q relayed\n\n", icons.OK, id); err != nil {
+		return err
+	}
+
+	return printRelayerStatus(session, statuses)
+}
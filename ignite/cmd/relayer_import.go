@@ -0,0 +1,81 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+)
+
+// NewRelayerImport returns a new relayer import command to add the chains and paths
+// of the config of another relayer to the relayer.
+func NewRelayerImport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import the chains and paths of a Hermes or rly config to the relayer",
+		Long: `Import the chains and paths of a Hermes or rly config to the relayer.
+
+Client, connection and channel ids that are missing in the config are queried from the chains,
+so the chains must be reachable. Accounts are referenced by their names in the keyring, make sure
+to import them through "ignite account import" before using the relayer.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerImportHandler,
+	}
+
+	c.Flags().String(flagFormat, string(relayer.FormatHermes), fmt.Sprintf("config format %v", relayer.Formats))
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerImportHandler(cmd *cobra.Command, args []string) error {
+	formatName, _ := cmd.Flags().GetString(flagFormat)
+
+	format, err := relayer.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	r := relayer.New(ca)
+
+	session.StartSpinner("Importing relayer config...")
+
+	pathIDs, err := r.Import(cmd.Context(), format, data)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if len(pathIDs) == 0 {
+		return session.Printf("%s No new paths to import\n", icons.OK)
+	}
+
+	for _, id := range pathIDs {
+		if err := session.Printf("%s Path %q imported\n", icons.OK, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package ignitecmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+)
+
+var relayerStatusHeader = []string{
+	"Path",
+	"Chain",
+	"Client",
+	"Client Height",
+	"Client Expiry",
+	"Connection",
+	"Channel",
+	"Unrelayed Packets",
+	"Unrelayed Acks",
+}
+
+// NewRelayerStatus returns a new relayer status command to show the state of the clients,
+// connections and channels of the paths and their packets waiting to be relayed.
+// if no paths are specified, the status of all paths is shown.
+func NewRelayerStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status [<path>,...]",
+		Short: "Show the clients, connections, channels and unrelayed packets of paths",
+		RunE:  relayerStatusHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerStatusHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	r := relayer.New(ca)
+
+	ids := args
+	if len(ids) == 0 {
+		paths, err := r.ListPaths(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		for _, path := range paths {
+			ids = append(ids, path.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		session.StopSpinner()
+		return session.Println("No paths found.")
+	}
+
+	session.StartSpinner("Querying chains...")
+
+	statuses, err := r.Status(cmd.Context(), ids...)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return printRelayerStatus(session, statuses)
+}
+
+func printRelayerStatus(session cliui.Session, statuses []relayer.PathStatus) error {
+	var entries [][]string
+
+	for _, s := range statuses {
+		ends := []struct {
+			chainID string
+			status  relayer.EndStatus
+		}{
+			{s.Path.Src.ChainID, s.Src},
+			{s.Path.Dst.ChainID, s.Dst},
+		}
+
+		for _, end := range ends {
+			// paths that aren't linked have no state on the chains.
+			if s.Path.Src.ConnectionID == "" {
+				entries = append(entries, []string{s.Path.ID, end.chainID, "-", "-", "-", "-", "-", "-", "-"})
+				continue
+			}
+
+			entries = append(entries, []string{
+				s.Path.ID,
+				end.chainID,
+				end.status.ClientID,
+				end.status.ClientHeight,
+				end.status.ClientExpiry.Format(time.RFC3339),
+				end.status.ConnectionState,
+				end.status.ChannelState,
+				strconv.Itoa(len(end.status.UnrelayedPackets)),
+				strconv.Itoa(len(end.status.UnrelayedAcks)),
+			})
+		}
+	}
+
+	return session.PrintTable(relayerStatusHeader, entries...)
+}
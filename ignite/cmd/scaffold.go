@@ -8,7 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/pkg/xgit"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
@@ -86,8 +86,10 @@ func scaffoldType(
 		}
 	}
 
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	sc, err := newApp(appPath)
 	if err != nil {
@@ -104,17 +106,9 @@ func scaffoldType(
 		return err
 	}
 
-	s.Stop()
-
-	modificationsStr, err := sourceModificationToString(sm)
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 %s added. \n\n", typeName)
+	session.StopSpinner()
 
-	return nil
+	return printSourceModification(session, sm, fmt.Sprintf("\n🎉 %s added. \n\n", typeName))
 }
 
 func addGitChangesVerifier(cmd *cobra.Command) *cobra.Command {
@@ -136,6 +130,11 @@ func addGitChangesVerifier(cmd *cobra.Command) *cobra.Command {
 		}
 
 		if !getYes(cmd) && !changesCommitted {
+			if getNonInteractive(cmd) {
+				return fmt.Errorf("%w: your saved project changes have not been committed, use --%s to proceed",
+					cliquiz.ErrNonInteractive, flagYes)
+			}
+
 			var confirmed bool
 			prompt := &survey.Confirm{
 				Message: "Your saved project changes have not been committed. To enable reverting to your current state, commit your saved changes. Do you want to proceed with scaffolding without committing your saved changes",
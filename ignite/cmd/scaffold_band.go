@@ -6,7 +6,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
 )
@@ -36,8 +35,10 @@ func createBandchainHandler(cmd *cobra.Command, args []string) error {
 		signer  = flagGetSigner(cmd)
 	)
 
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	module, err := cmd.Flags().GetString(flagModule)
 	if err != nil {
@@ -67,16 +68,9 @@ func createBandchainHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
+	session.StopSpinner()
 
-	modificationsStr, err := sourceModificationToString(sm)
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(modificationsStr)
-
-	fmt.Printf(`
+	return printSourceModification(session, sm, fmt.Sprintf(`
 🎉 Created a Band oracle query "%[1]v".
 
 Note: BandChain module uses version "bandchain-1".
@@ -85,7 +79,5 @@ Make sure to update the keys.go file accordingly.
 // x/%[2]v/types/keys.go
 const Version = "bandchain-1"
 
-`, oracle, module)
-
-	return nil
+`, oracle, module))
 }
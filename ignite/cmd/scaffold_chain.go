@@ -1,11 +1,8 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
 )
@@ -14,6 +11,11 @@ const (
 	flagNoDefaultModule = "no-module"
 )
 
+// scaffoldChainResult is the result of the chain scaffolding printed in JSON output.
+type scaffoldChainResult struct {
+	Path string `json:"path"`
+}
+
 // NewScaffoldChain creates new command to scaffold a Comos-SDK based blockchain.
 func NewScaffoldChain() *cobra.Command {
 	c := &cobra.Command{
@@ -56,8 +58,10 @@ The blockchain is using the Cosmos SDK modular blockchain framework. Learn more
 }
 
 func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	var (
 		name               = args[0]
@@ -76,7 +80,7 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
+	session.StopSpinner()
 
 	path, err := relativePath(appdir)
 	if err != nil {
@@ -92,7 +96,7 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 
 Documentation: https://docs.ignite.com
 `
-	fmt.Printf(message, path)
-
-	return nil
+	return session.PrintResult(scaffoldChainResult{Path: path}, func() error {
+		return session.Printf(message, path)
+	})
 }
@@ -1,11 +1,8 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
 )
 
@@ -24,16 +21,17 @@ func NewScaffoldFlutter() *cobra.Command {
 }
 
 func scaffoldFlutterHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	path := flagGetPath(cmd)
 	if err := scaffolder.Flutter(path); err != nil {
 		return err
 	}
 
-	s.Stop()
-	fmt.Printf("\n🎉 Scaffold a Flutter app.\n\n")
+	session.StopSpinner()
 
-	return nil
+	return session.Printf("\n🎉 Scaffold a Flutter app.\n\n")
 }
@@ -5,7 +5,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
 )
@@ -42,8 +41,10 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		withoutSimulation = flagGetNoSimulation(cmd)
 	)
 
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
@@ -77,15 +78,7 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
-
-	modificationsStr, err := sourceModificationToString(sm)
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 Created a message `%[1]v`.\n\n", args[0])
+	session.StopSpinner()
 
-	return nil
+	return printSourceModification(session, sm, fmt.Sprintf("\n🎉 Created a message `%[1]v`.\n\n", args[0]))
 }
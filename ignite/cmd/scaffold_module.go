@@ -9,7 +9,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/pkg/validation"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
@@ -50,8 +49,10 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		name    = args[0]
 		appPath = flagGetPath(cmd)
 	)
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	ibcModule, err := cmd.Flags().GetBool(flagIBC)
 	if err != nil {
@@ -112,39 +113,32 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithDependencies(formattedDependencies))
 	}
 
-	var msg bytes.Buffer
-	fmt.Fprintf(&msg, "\n🎉 Module created %s.\n\n", name)
-
 	sc, err := newApp(appPath)
 	if err != nil {
 		return err
 	}
 
+	var msg bytes.Buffer
+
 	sm, err := sc.CreateModule(cacheStorage, placeholder.New(), name, options...)
-	s.Stop()
+	session.StopSpinner()
+
+	if len(dependencies) > 0 {
+		dependencyWarning(&msg, dependencies)
+	}
+
+	fmt.Fprintf(&msg, "\n🎉 Module created %s.\n\n", name)
+
 	if err != nil {
 		var validationErr validation.Error
-		if !requireRegistration && errors.As(err, &validationErr) {
-			fmt.Fprintf(&msg, "Can't register module '%s'.\n", name)
-			fmt.Fprintln(&msg, validationErr.ValidationInfo())
-		} else {
-			return err
-		}
-	} else {
-		modificationsStr, err := sourceModificationToString(sm)
-		if err != nil {
+		if requireRegistration || !errors.As(err, &validationErr) {
 			return err
 		}
-
-		fmt.Println(modificationsStr)
-	}
-
-	if len(dependencies) > 0 {
-		dependencyWarning(dependencies)
+		fmt.Fprintf(&msg, "Can't register module '%s'.\n", name)
+		fmt.Fprintln(&msg, validationErr.ValidationInfo())
 	}
 
-	io.Copy(cmd.OutOrStdout(), &msg)
-	return nil
+	return printSourceModification(session, sm, msg.String())
 }
 
 // in previously scaffolded apps gov keeper is defined below the scaffolded module keeper definition
@@ -158,11 +152,11 @@ app.GovKeeper = ...
 [your module keeper definition]
 `
 
-// dependencyWarning is used to print a warning if gov is provided as a dependency
-func dependencyWarning(dependencies []string) {
+// dependencyWarning is used to write a warning to w if gov is provided as a dependency
+func dependencyWarning(w io.Writer, dependencies []string) {
 	for _, dep := range dependencies {
 		if dep == "gov" {
-			fmt.Print(govWarning)
+			fmt.Fprint(w, govWarning)
 		}
 	}
 }
@@ -6,7 +6,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
 )
@@ -36,8 +35,10 @@ func NewScaffoldPacket() *cobra.Command {
 }
 
 func createPacketHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	var (
 		packet       = args[0]
@@ -86,15 +87,7 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
-
-	modificationsStr, err := sourceModificationToString(sm)
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 Created a packet `%[1]v`.\n\n", args[0])
+	session.StopSpinner()
 
-	return nil
+	return printSourceModification(session, sm, fmt.Sprintf("\n🎉 Created a packet `%[1]v`.\n\n", args[0]))
 }
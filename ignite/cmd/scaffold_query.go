@@ -5,7 +5,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 )
 
@@ -35,8 +34,10 @@ func NewScaffoldQuery() *cobra.Command {
 func queryHandler(cmd *cobra.Command, args []string) error {
 	appPath := flagGetPath(cmd)
 
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := newSession(cmd)
+	defer session.Cleanup()
+
+	session.StartSpinner("Scaffolding...")
 
 	// Get the module to add the type into
 	module, err := cmd.Flags().GetString(flagModule)
@@ -80,15 +81,7 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	s.Stop()
-
-	modificationsStr, err := sourceModificationToString(sm)
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 Created a query `%[1]v`.\n\n", args[0])
+	session.StopSpinner()
 
-	return nil
+	return printSourceModification(session, sm, fmt.Sprintf("\n🎉 Created a query `%[1]v`.\n\n", args[0]))
 }
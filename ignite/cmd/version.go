@@ -1,8 +1,6 @@
 package ignitecmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ignite-hq/cli/ignite/version"
@@ -13,8 +11,11 @@ func NewVersion() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "version",
 		Short: "Print the current build information",
-		Run: func(cmd *cobra.Command, _ []string) {
-			fmt.Println(version.Long(cmd.Context()))
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			session := newSession(cmd)
+			defer session.Cleanup()
+
+			return session.Println(version.Long(cmd.Context()))
 		},
 	}
 	return c
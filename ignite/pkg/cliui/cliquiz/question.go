@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"unicode"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -19,6 +20,7 @@ var ErrConfirmationFailed = errors.New("failed to confirm, your answers were dif
 // Question holds information on what to ask to user and where
 // the answer stored at.
 type Question struct {
+	key           string
 	question      string
 	defaultAnswer interface{}
 	answer        interface{}
@@ -51,6 +53,14 @@ func HideAnswer() Option {
 	}
 }
 
+// Key sets the key of Question to pre-fill its answer with, by default the key is
+// the question in snake case, e.g. "source_rpc" for "Source RPC".
+func Key(key string) Option {
+	return func(q *Question) {
+		q.key = key
+	}
+}
+
 // GetConfirmation prompts confirmation for the given answer.
 func GetConfirmation() Option {
 	return func(q *Question) {
@@ -61,6 +71,7 @@ func GetConfirmation() Option {
 // NewQuestion creates a new question.
 func NewQuestion(question string, answer interface{}, options ...Option) Question {
 	q := Question{
+		key:      questionKey(question),
 		question: question,
 		answer:   answer,
 	}
@@ -70,6 +81,20 @@ func NewQuestion(question string, answer interface{}, options ...Option) Questio
 	return q
 }
 
+// Key returns the key of the question.
+func (q Question) Key() string {
+	return q.key
+}
+
+// questionKey converts question to snake case.
+func questionKey(question string) string {
+	question = strings.ReplaceAll(question, "'", "")
+	words := strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(words, "_")
+}
+
 func ask(q Question) error {
 	var prompt survey.Prompt
 
@@ -118,7 +143,12 @@ func ask(q Question) error {
 }
 
 // Ask asks questions and collect answers.
-func Ask(question ...Question) (err error) {
+func Ask(question ...Question) error {
+	return NewQuiz().Ask(question...)
+}
+
+// askAll prompts the questions.
+func askAll(question ...Question) (err error) {
 	defer func() {
 		if err == terminal.InterruptErr {
 			err = context.Canceled
@@ -192,6 +222,7 @@ func ValuesFromFlagsOrAsk(fset *pflag.FlagSet, title string, flags ...Flag) (val
 		if f.IsRequired {
 			options = append(options, Required())
 		}
+		options = append(options, Key(f.Name))
 		questions = append(questions, NewQuestion(flag.Usage, &value, options...))
 	}
 
@@ -0,0 +1,176 @@
+package cliquiz
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// ErrNonInteractive is returned when an answer is required in non-interactive mode.
+var ErrNonInteractive = errors.New("cannot prompt in non-interactive mode")
+
+// MissingAnswersError is returned in non-interactive mode when required questions
+// have no answers.
+type MissingAnswersError struct {
+	Keys []string
+}
+
+// Error implements error.
+func (e MissingAnswersError) Error() string {
+	return fmt.Sprintf("%s, missing answers: %s", ErrNonInteractive, strings.Join(e.Keys, ", "))
+}
+
+// Is checks if target is ErrNonInteractive.
+func (e MissingAnswersError) Is(target error) bool {
+	return target == ErrNonInteractive
+}
+
+// Answers are the answers of questions by their keys.
+type Answers map[string]string
+
+// LoadAnswers loads the answers from the YAML file at path that maps the keys of
+// the questions to their answers, e.g. "source_gas_limit: 300000".
+func LoadAnswers(path string) (Answers, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("invalid answers file %s: %w", path, err)
+	}
+
+	answers := make(Answers)
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+		answers[key] = fmt.Sprint(value)
+	}
+	return answers, nil
+}
+
+// Quiz answers questions with pre-filled answers and asks the rest to the user.
+type Quiz struct {
+	answers        Answers
+	nonInteractive bool
+}
+
+// QuizOption configures Quiz.
+type QuizOption func(*Quiz)
+
+// WithAnswers pre-fills the answers of the questions by their keys.
+func WithAnswers(answers Answers) QuizOption {
+	return func(z *Quiz) {
+		z.answers = answers
+	}
+}
+
+// WithNonInteractive never prompts the user. questions without pre-filled answers are
+// answered with their default answers and an error naming the missing answers is returned
+// when there are required questions left.
+func WithNonInteractive() QuizOption {
+	return func(z *Quiz) {
+		z.nonInteractive = true
+	}
+}
+
+// NewQuiz creates a new quiz.
+func NewQuiz(options ...QuizOption) Quiz {
+	var z Quiz
+	for _, apply := range options {
+		apply(&z)
+	}
+	return z
+}
+
+// IsInteractive checks if the user can be prompted.
+func (z Quiz) IsInteractive() bool {
+	return !z.nonInteractive
+}
+
+// Ask answers questions from the pre-filled answers and asks the others.
+func (z Quiz) Ask(questions ...Question) error {
+	var (
+		unanswered []Question
+		missing    []string
+	)
+
+	for _, q := range questions {
+		if answer, ok := z.answers[q.key]; ok {
+			if err := setAnswer(q.answer, answer); err != nil {
+				return fmt.Errorf("invalid answer for %q: %w", q.key, err)
+			}
+			continue
+		}
+
+		if !z.nonInteractive {
+			unanswered = append(unanswered, q)
+			continue
+		}
+
+		if q.defaultAnswer != nil {
+			if err := setAnswer(q.answer, fmt.Sprint(q.defaultAnswer)); err != nil {
+				return fmt.Errorf("invalid default answer for %q: %w", q.key, err)
+			}
+			continue
+		}
+
+		if q.required {
+			missing = append(missing, q.key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return MissingAnswersError{Keys: missing}
+	}
+
+	return askAll(unanswered...)
+}
+
+// setAnswer parses value into answer.
+func setAnswer(answer interface{}, value string) error {
+	v := reflect.ValueOf(answer)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("answer must be a pointer")
+	}
+	v = v.Elem()
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported answer type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package cliquiz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuestionKey(t *testing.T) {
+	require.Equal(t, "source_rpc", NewQuestion("Source RPC", nil).Key())
+	require.Equal(t, "peers_address", NewQuestion("Peer's address", nil).Key())
+	require.Equal(t, "peer", NewQuestion("Peer's address", nil, Key("peer")).Key())
+}
+
+func TestQuizAsk(t *testing.T) {
+	var (
+		rpc      string
+		gasLimit int64
+		faucet   string
+		port     string
+	)
+
+	quiz := NewQuiz(
+		WithAnswers(Answers{"source_rpc": "http://localhost:26657", "source_gas_limit": "300000"}),
+		WithNonInteractive(),
+	)
+	err := quiz.Ask(
+		NewQuestion("Source RPC", &rpc, Required()),
+		NewQuestion("Source Gas Limit", &gasLimit, Required()),
+		NewQuestion("Source Faucet", &faucet),
+		NewQuestion("Source Port", &port, DefaultAnswer("transfer"), Required()),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:26657", rpc)
+	require.Equal(t, int64(300000), gasLimit)
+	require.Empty(t, faucet)
+	require.Equal(t, "transfer", port)
+}
+
+func TestQuizAskMissingAnswers(t *testing.T) {
+	var rpc, account string
+
+	err := NewQuiz(WithNonInteractive()).Ask(
+		NewQuestion("Source RPC", &rpc, Required()),
+		NewQuestion("Source Account", &account, Required()),
+	)
+	require.ErrorIs(t, err, ErrNonInteractive)
+	require.Equal(t, MissingAnswersError{Keys: []string{"source_rpc", "source_account"}}, err)
+}
+
+func TestQuizAskInvalidAnswer(t *testing.T) {
+	var gasLimit int64
+
+	err := NewQuiz(WithAnswers(Answers{"source_gas_limit": "a lot"})).Ask(
+		NewQuestion("Source Gas Limit", &gasLimit),
+	)
+	require.Error(t, err)
+}
+
+func TestLoadAnswers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+source_rpc: http://localhost:26657
+source_gas_limit: 300000
+source_faucet:
+`), 0644))
+
+	answers, err := LoadAnswers(path)
+	require.NoError(t, err)
+	require.Equal(t, Answers{
+		"source_rpc":       "http://localhost:26657",
+		"source_gas_limit": "300000",
+	}, answers)
+}
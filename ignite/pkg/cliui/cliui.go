@@ -25,6 +25,11 @@ type Session struct {
 	in          io.Reader
 	out         io.Writer
 	printLoopWg *sync.WaitGroup
+
+	format OutputFormat
+	outMu  *sync.Mutex // protects out in JSON output.
+
+	quiz cliquiz.Quiz
 }
 
 type Option func(s *Session)
@@ -43,6 +48,20 @@ func WithInput(input io.Reader) Option {
 	}
 }
 
+// WithOutputFormat sets the output format of a session.
+func WithOutputFormat(format OutputFormat) Option {
+	return func(s *Session) {
+		s.format = format
+	}
+}
+
+// WithQuiz sets the quiz that answers the questions asked to the user.
+func WithQuiz(quiz cliquiz.Quiz) Option {
+	return func(s *Session) {
+		s.quiz = quiz
+	}
+}
+
 // New creates new Session.
 func New(options ...Option) Session {
 	wg := &sync.WaitGroup{}
@@ -52,11 +71,19 @@ func New(options ...Option) Session {
 		out:         os.Stdout,
 		eventsWg:    wg,
 		printLoopWg: &sync.WaitGroup{},
+		format:      OutputText,
+		outMu:       &sync.Mutex{},
+		quiz:        cliquiz.NewQuiz(),
 	}
 	for _, apply := range options {
 		apply(&session)
 	}
-	session.spinner = clispinner.New(clispinner.WithWriter(session.out))
+	// the spinner is never shown in JSON output, it would break the stream of JSON objects.
+	spinnerOut := session.out
+	if session.IsJSON() {
+		spinnerOut = io.Discard
+	}
+	session.spinner = clispinner.New(clispinner.WithWriter(spinnerOut))
 	session.printLoopWg.Add(1)
 	go session.printLoop()
 	return session
@@ -68,17 +95,28 @@ func (s Session) EventBus() events.Bus {
 }
 
 // StartSpinner starts spinner.
+// in JSON output, the text of the spinner is printed as an ongoing event.
 func (s Session) StartSpinner(text string) {
+	if s.IsJSON() {
+		s.printEvent(events.NewOngoing(text))
+		return
+	}
 	s.spinner.SetText(text).Start()
 }
 
 // StopSpinner stops spinner.
 func (s Session) StopSpinner() {
+	if s.IsJSON() {
+		return
+	}
 	s.spinner.Stop()
 }
 
 // PauseSpinner pauses spinner, returns resume function to start paused spinner again.
 func (s Session) PauseSpinner() (mightResume func()) {
+	if s.IsJSON() {
+		return func() {}
+	}
 	isActive := s.spinner.IsActive()
 	f := func() {
 		if isActive {
@@ -92,6 +130,9 @@ func (s Session) PauseSpinner() (mightResume func()) {
 // Printf prints formatted arbitrary message.
 func (s Session) Printf(format string, a ...interface{}) error {
 	s.Wait()
+	if s.IsJSON() {
+		return s.printMessage(fmt.Sprintf(format, a...))
+	}
 	defer s.PauseSpinner()()
 	_, err := fmt.Fprintf(s.out, format, a...)
 	return err
@@ -100,6 +141,9 @@ func (s Session) Printf(format string, a ...interface{}) error {
 // Println prints arbitrary message with line break.
 func (s Session) Println(messages ...interface{}) error {
 	s.Wait()
+	if s.IsJSON() {
+		return s.printMessage(fmt.Sprintln(messages...))
+	}
 	defer s.PauseSpinner()()
 	_, err := fmt.Fprintln(s.out, messages...)
 	return err
@@ -113,6 +157,9 @@ func (s Session) PrintSaidNo() error {
 // Println prints arbitrary message
 func (s Session) Print(messages ...interface{}) error {
 	s.Wait()
+	if s.IsJSON() {
+		return s.printMessage(fmt.Sprint(messages...))
+	}
 	defer s.PauseSpinner()()
 	_, err := fmt.Fprint(s.out, messages...)
 	return err
@@ -122,11 +169,15 @@ func (s Session) Print(messages ...interface{}) error {
 func (s Session) Ask(questions ...cliquiz.Question) error {
 	s.Wait()
 	defer s.PauseSpinner()()
-	return cliquiz.Ask(questions...)
+	return s.quiz.Ask(questions...)
 }
 
 // AskConfirm asks yes/no question in the terminal.
+// in non-interactive mode, an error wrapping cliquiz.ErrNonInteractive is returned.
 func (s Session) AskConfirm(message string) error {
+	if !s.quiz.IsInteractive() {
+		return fmt.Errorf("%w: %s?", cliquiz.ErrNonInteractive, message)
+	}
 	s.Wait()
 	defer s.PauseSpinner()()
 	prompt := promptui.Prompt{
@@ -140,10 +191,35 @@ func (s Session) AskConfirm(message string) error {
 // PrintTable prints table data.
 func (s Session) PrintTable(header []string, entries ...[]string) error {
 	s.Wait()
+	if s.IsJSON() {
+		return s.printJSON(record{Type: recordTable, Header: header, Rows: entries})
+	}
 	defer s.PauseSpinner()()
 	return entrywriter.MustWrite(s.out, header, entries...)
 }
 
+// PrintResult prints the final result of a command. in JSON output, result is
+// printed as a JSON object, otherwise print is called to print it as text.
+func (s Session) PrintResult(result interface{}, print func() error) error {
+	s.Wait()
+	if s.IsJSON() {
+		return s.printJSON(record{Type: recordResult, Result: result})
+	}
+	return print()
+}
+
+// PrintError prints the error that made a command fail. in JSON output, err is
+// printed as an error record so the consumers of the output can tell failures apart.
+func (s Session) PrintError(err error) error {
+	s.Wait()
+	if s.IsJSON() {
+		return s.printError(err)
+	}
+	defer s.PauseSpinner()()
+	_, perr := fmt.Fprintln(s.out, err)
+	return perr
+}
+
 // Wait blocks until all queued events are handled.
 func (s Session) Wait() {
 	s.eventsWg.Wait()
@@ -159,6 +235,12 @@ func (s Session) Cleanup() {
 // printLoop handles events.
 func (s Session) printLoop() {
 	for event := range s.ev.Events() {
+		if s.IsJSON() {
+			s.printEvent(event)
+			s.eventsWg.Done()
+			continue
+		}
+
 		switch event.Status {
 		case events.StatusOngoing:
 			s.StartSpinner(event.Text())
@@ -0,0 +1,97 @@
+package cliui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gookit/color"
+
+	"github.com/ignite-hq/cli/ignite/pkg/events"
+)
+
+// OutputFormat is the format of the output of a session.
+type OutputFormat string
+
+const (
+	// OutputText prints human readable text with colors and spinners.
+	OutputText OutputFormat = "text"
+
+	// OutputJSON prints a JSON object per line for each event, message and result,
+	// so the output can be consumed by other programs.
+	OutputJSON OutputFormat = "json"
+)
+
+// OutputFormats are the supported output formats.
+var OutputFormats = []OutputFormat{OutputText, OutputJSON}
+
+// ParseOutputFormat parses an output format from its name.
+func ParseOutputFormat(name string) (OutputFormat, error) {
+	for _, f := range OutputFormats {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown output format %q, supported formats are %v", name, OutputFormats)
+}
+
+// types of the records printed in JSON output.
+const (
+	recordEvent   = "event"
+	recordMessage = "message"
+	recordTable   = "table"
+	recordResult  = "result"
+	recordError   = "error"
+)
+
+// record is a line of JSON output.
+type record struct {
+	Type        string      `json:"type"`
+	Status      string      `json:"status,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Payload     interface{} `json:"payload,omitempty"`
+	Text        string      `json:"text,omitempty"`
+	Header      []string    `json:"header,omitempty"`
+	Rows        [][]string  `json:"rows,omitempty"`
+	Result      interface{} `json:"result,omitempty"`
+	Error       string      `json:"error,omitempty"`
+}
+
+// IsJSON checks if the session prints JSON output.
+func (s Session) IsJSON() bool {
+	return s.format == OutputJSON
+}
+
+// printEvent prints event as a JSON record.
+func (s Session) printEvent(event events.Event) {
+	// errors cannot be returned from the print loop, the next writes fail too.
+	_ = s.printJSON(record{
+		Type:        recordEvent,
+		Status:      event.Status.String(),
+		Description: color.ClearCode(event.Description),
+		Payload:     event.Payload,
+	})
+}
+
+// printMessage prints an arbitrary text message as a JSON record.
+// messages that only contain spaces are skipped.
+func (s Session) printMessage(text string) error {
+	text = strings.TrimSpace(color.ClearCode(text))
+	if text == "" {
+		return nil
+	}
+	return s.printJSON(record{Type: recordMessage, Text: text})
+}
+
+// printError prints err as a JSON record.
+func (s Session) printError(err error) error {
+	return s.printJSON(record{Type: recordError, Error: color.ClearCode(err.Error())})
+}
+
+// printJSON prints r as a line of JSON.
+func (s Session) printJSON(r record) error {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+
+	return json.NewEncoder(s.out).Encode(r)
+}
@@ -0,0 +1,72 @@
+package cliui_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/events"
+)
+
+func TestJSONOutput(t *testing.T) {
+	var (
+		out     bytes.Buffer
+		session = cliui.New(cliui.WithOutput(&out), cliui.WithOutputFormat(cliui.OutputJSON))
+		payload = map[string]uint64{"launch_id": 3}
+	)
+
+	session.StartSpinner("Fetching")
+	session.EventBus().Send(events.New(events.StatusDone, "Fetched", events.Payload(payload)))
+	require.NoError(t, session.Printf("\n%s\n", "hello"))
+	require.NoError(t, session.Println(" "))
+	require.NoError(t, session.PrintTable([]string{"ID"}, []string{"1"}))
+	require.NoError(t, session.PrintResult(payload, func() error {
+		t.Fatal("text is printed in JSON output")
+		return nil
+	}))
+	session.Cleanup()
+
+	require.Equal(t, `{"type":"event","status":"ongoing","description":"Fetching"}
+{"type":"event","status":"done","description":"Fetched","payload":{"launch_id":3}}
+{"type":"message","text":"hello"}
+{"type":"table","header":["ID"],"rows":[["1"]]}
+{"type":"result","result":{"launch_id":3}}
+`, out.String())
+}
+
+func TestJSONOutputWithoutSpinner(t *testing.T) {
+	var out bytes.Buffer
+	session := cliui.New(cliui.WithOutput(&out), cliui.WithOutputFormat(cliui.OutputJSON))
+
+	// give the spinner the time to render a frame.
+	time.Sleep(300 * time.Millisecond)
+	require.NoError(t, session.Println("hello"))
+	session.Cleanup()
+
+	require.Equal(t, `{"type":"message","text":"hello"}
+`, out.String())
+}
+
+func TestJSONOutputError(t *testing.T) {
+	var out bytes.Buffer
+	session := cliui.New(cliui.WithOutput(&out), cliui.WithOutputFormat(cliui.OutputJSON))
+
+	require.NoError(t, session.PrintError(errors.New("failed")))
+	session.Cleanup()
+
+	require.Equal(t, `{"type":"error","error":"failed"}
+`, out.String())
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	format, err := cliui.ParseOutputFormat("json")
+	require.NoError(t, err)
+	require.Equal(t, cliui.OutputJSON, format)
+
+	_, err = cliui.ParseOutputFormat("yaml")
+	require.Error(t, err)
+}
@@ -124,6 +124,14 @@ func WithNodeAddress(addr string) Option {
 	}
 }
 
+// WithAccountRegistry sets the registry to access the accounts that sign transactions.
+// when it is provided, the keyring options are ignored.
+func WithAccountRegistry(registry cosmosaccount.Registry) Option {
+	return func(c *Client) {
+		c.AccountRegistry = registry
+	}
+}
+
 func WithAddressPrefix(prefix string) Option {
 	return func(c *Client) {
 		c.addressPrefix = prefix
@@ -180,13 +188,15 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		c.homePath = filepath.Join(home, "."+c.chainID)
 	}
 
-	c.AccountRegistry, err = cosmosaccount.New(
-		cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
-		cosmosaccount.WithKeyringBackend(c.keyringBackend),
-		cosmosaccount.WithHome(c.homePath),
-	)
-	if err != nil {
-		return Client{}, err
+	if c.AccountRegistry.Keyring == nil {
+		c.AccountRegistry, err = cosmosaccount.New(
+			cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
+			cosmosaccount.WithKeyringBackend(c.keyringBackend),
+			cosmosaccount.WithHome(c.homePath),
+		)
+		if err != nil {
+			return Client{}, err
+		}
 	}
 
 	c.context = newContext(c.RPC, c.out, c.chainID, c.homePath).WithKeyring(c.AccountRegistry.Keyring)
@@ -0,0 +1,131 @@
+package cosmosdocs
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	cobraPackage         = "cobra"
+	cobraCommand         = "Command"
+	queryClientName      = "queryClient"
+	msgConstructorPrefix = "NewMsg"
+)
+
+// CLICommand is a CLI command of a module as scaffolded by Ignite CLI.
+type CLICommand struct {
+	// Use is the usage line of the command, e.g. create-post [title] [body].
+	Use string
+
+	// Short is the short description of the command.
+	Short string
+
+	// Msg is the name of the Msg broadcasted by the command, if any.
+	Msg string
+
+	// Query is the name of the query RPC func called by the command, if any.
+	Query string
+}
+
+// FindCLICommands finds the CLI commands defined in the Go package at path.
+// Each command is expected to be returned by a func that creates a cobra.Command
+// and either creates a Msg with its New func or calls an RPC func of the query client.
+func FindCLICommands(path string) ([]CLICommand, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), path, nil, 0)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var commands []CLICommand
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Body == nil {
+					continue
+				}
+
+				if c, ok := findCLICommand(fn.Body); ok {
+					commands = append(commands, c)
+				}
+			}
+		}
+	}
+
+	return commands, nil
+}
+
+func findCLICommand(body *ast.BlockStmt) (c CLICommand, found bool) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CompositeLit:
+			if !isCobraCommand(n.Type) {
+				return true
+			}
+
+			found = true
+			for _, el := range n.Elts {
+				kv, ok := el.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+
+				key, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					continue
+				}
+
+				switch key.Name {
+				case "Use":
+					c.Use = stringLit(kv.Value)
+				case "Short":
+					c.Short = stringLit(kv.Value)
+				}
+			}
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok && x.Name == queryClientName {
+				c.Query = n.Sel.Name
+			}
+			if strings.HasPrefix(n.Sel.Name, msgConstructorPrefix) {
+				c.Msg = strings.TrimPrefix(n.Sel.Name, "New")
+			}
+		}
+
+		return true
+	})
+
+	// commands that group other commands have a non literal usage.
+	return c, found && c.Use != "" && (c.Msg != "" || c.Query != "")
+}
+
+func isCobraCommand(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == cobraPackage && sel.Sel.Name == cobraCommand
+}
+
+func stringLit(expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
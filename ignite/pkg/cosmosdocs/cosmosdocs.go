@@ -0,0 +1,257 @@
+// Package cosmosdocs generates Markdown API references for the modules of Cosmos SDK apps
+// from their proto files and scaffolded CLI commands.
+package cosmosdocs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite-hq/cli/ignite/pkg/protoanalysis"
+)
+
+const (
+	serviceMsg   = "Msg"
+	serviceQuery = "Query"
+
+	messageParams  = "Params"
+	messageGenesis = "GenesisState"
+
+	typedEventPrefix = "Event"
+)
+
+// Generate generates a Markdown reference for each module of the app at appPath
+// into outPath and returns the paths of the generated files.
+// binaryName is the name of the app's binary used to show CLI commands.
+func Generate(ctx context.Context, appPath, protoDir, binaryName, outPath string) ([]string, error) {
+	modules, err := module.Discover(ctx, appPath, appPath, protoDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(outPath, 0755); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+
+	for _, m := range modules {
+		// modules without services or msgs are returned empty by the discovery.
+		if m.Name == "" {
+			continue
+		}
+
+		// the go_package of a module's proto package is the types package
+		// of the module which lives in the module's directory.
+		typesPath := filepath.Join(appPath, strings.TrimPrefix(m.Pkg.GoImportPath(), m.GoModulePath))
+		modulePath := filepath.Dir(typesPath)
+
+		commands, err := FindCLICommands(filepath.Join(modulePath, "client", "cli"))
+		if err != nil {
+			return nil, err
+		}
+
+		eventTypes, err := FindEventTypes(typesPath)
+		if err != nil {
+			return nil, err
+		}
+
+		doc := Module{
+			Module:     m,
+			BinaryName: binaryName,
+			Commands:   commands,
+			EventTypes: eventTypes,
+		}
+
+		path := filepath.Join(outPath, m.Name+".md")
+		if err := os.WriteFile(path, []byte(doc.Markdown()), 0644); err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// Module holds the information needed to document a module.
+type Module struct {
+	module.Module
+
+	// BinaryName is the name of the app's binary.
+	BinaryName string
+
+	// Commands is a list of CLI commands of the module.
+	Commands []CLICommand
+
+	// EventTypes is a list of event types emitted by the module.
+	EventTypes []EventType
+}
+
+// Markdown renders the module reference in Markdown.
+func (m Module) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# `%s` module\n\n", m.Name)
+	fmt.Fprintf(&b, "Reference of the `%s` module generated from the `%s` proto package.\n", m.Name, m.Pkg.Name)
+
+	m.writeMessages(&b)
+	m.writeQueries(&b)
+	m.writeEvents(&b)
+	m.writeMessageSection(&b, "Params", messageParams, "The module has no params.")
+	m.writeMessageSection(&b, "Genesis", messageGenesis, "The module has no genesis state.")
+
+	return b.String()
+}
+
+func (m Module) writeMessages(b *strings.Builder) {
+	rpcFuncs := m.rpcFuncs(serviceMsg)
+	if len(rpcFuncs) == 0 {
+		return
+	}
+
+	b.WriteString("\n## Messages\n")
+
+	for _, rpc := range rpcFuncs {
+		msg, _ := m.Pkg.MessageByName(rpc.RequestType)
+
+		fmt.Fprintf(b, "\n### %s\n\n", rpc.RequestType)
+		writeComment(b, rpc.Comment, msg.Comment)
+
+		if c, ok := m.command(func(c CLICommand) bool { return c.Msg == rpc.RequestType }); ok {
+			fmt.Fprintf(b, "CLI: `%s tx %s %s`\n\n", m.BinaryName, m.Name, c.Use)
+		}
+
+		writeFields(b, msg.Fields)
+	}
+}
+
+func (m Module) writeQueries(b *strings.Builder) {
+	rpcFuncs := m.rpcFuncs(serviceQuery)
+	if len(rpcFuncs) == 0 {
+		return
+	}
+
+	b.WriteString("\n## Queries\n")
+
+	for _, rpc := range rpcFuncs {
+		fmt.Fprintf(b, "\n### %s\n\n", rpc.Name)
+		writeComment(b, rpc.Comment)
+
+		for _, rule := range rpc.HTTPRules {
+			if rule.Endpoint != "" {
+				fmt.Fprintf(b, "REST: `%s %s`\n\n", rule.Method, rule.Endpoint)
+			}
+		}
+
+		if c, ok := m.command(func(c CLICommand) bool { return c.Query == rpc.Name }); ok {
+			fmt.Fprintf(b, "CLI: `%s query %s %s`\n\n", m.BinaryName, m.Name, c.Use)
+		}
+
+		req, _ := m.Pkg.MessageByName(rpc.RequestType)
+		fmt.Fprintf(b, "Request `%s`:\n\n", rpc.RequestType)
+		writeFields(b, req.Fields)
+
+		res, _ := m.Pkg.MessageByName(rpc.ReturnsType)
+		fmt.Fprintf(b, "\nResponse `%s`:\n\n", rpc.ReturnsType)
+		writeFields(b, res.Fields)
+	}
+}
+
+func (m Module) writeEvents(b *strings.Builder) {
+	var typedEvents []protoanalysis.Message
+	for _, msg := range m.Pkg.Messages {
+		if strings.HasPrefix(msg.Name, typedEventPrefix) {
+			typedEvents = append(typedEvents, msg)
+		}
+	}
+
+	if len(typedEvents) == 0 && len(m.EventTypes) == 0 {
+		return
+	}
+
+	b.WriteString("\n## Events\n")
+
+	for _, event := range typedEvents {
+		fmt.Fprintf(b, "\n### %s\n\n", event.Name)
+		writeComment(b, event.Comment)
+		writeFields(b, event.Fields)
+	}
+
+	if len(m.EventTypes) > 0 {
+		b.WriteString("\n### Event types\n\n")
+		b.WriteString("| Type | Description |\n")
+		b.WriteString("| ---- | ----------- |\n")
+		for _, e := range m.EventTypes {
+			fmt.Fprintf(b, "| `%s` | %s |\n", e.Value, tableText(e.Comment))
+		}
+	}
+}
+
+func (m Module) writeMessageSection(b *strings.Builder, title, messageName, empty string) {
+	fmt.Fprintf(b, "\n## %s\n\n", title)
+
+	msg, err := m.Pkg.MessageByName(messageName)
+	if err != nil || len(msg.Fields) == 0 {
+		fmt.Fprintf(b, "%s\n", empty)
+		return
+	}
+
+	writeComment(b, msg.Comment)
+	writeFields(b, msg.Fields)
+}
+
+func (m Module) rpcFuncs(serviceName string) []protoanalysis.RPCFunc {
+	for _, s := range m.Pkg.Services {
+		if s.Name == serviceName {
+			return s.RPCFuncs
+		}
+	}
+	return nil
+}
+
+func (m Module) command(match func(CLICommand) bool) (CLICommand, bool) {
+	for _, c := range m.Commands {
+		if match(c) {
+			return c, true
+		}
+	}
+	return CLICommand{}, false
+}
+
+// writeComment writes the first non empty comment as a paragraph.
+func writeComment(b *strings.Builder, comments ...string) {
+	for _, c := range comments {
+		if c != "" {
+			fmt.Fprintf(b, "%s\n\n", c)
+			return
+		}
+	}
+}
+
+func writeFields(b *strings.Builder, fields []protoanalysis.Field) {
+	if len(fields) == 0 {
+		b.WriteString("No fields.\n")
+		return
+	}
+
+	b.WriteString("| Field | Type | Description |\n")
+	b.WriteString("| ----- | ---- | ----------- |\n")
+	for _, f := range fields {
+		typeName := f.Type
+		if f.Repeated {
+			typeName = "repeated " + typeName
+		}
+
+		fmt.Fprintf(b, "| `%s` | `%s` | %s |\n", f.Name, typeName, tableText(f.Comment))
+	}
+}
+
+// tableText makes text safe to use inside of a table cell.
+func tableText(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
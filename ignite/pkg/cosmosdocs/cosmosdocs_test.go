@@ -0,0 +1,132 @@
+package cosmosdocs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite-hq/cli/ignite/pkg/protoanalysis"
+)
+
+func TestFindCLICommands(t *testing.T) {
+	commands, err := FindCLICommands("testdata/x/mars/client/cli")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []CLICommand{
+		{Use: "create-post [title] [body]", Short: "Create a new post", Msg: "MsgCreatePost"},
+		{Use: "show-post [id]", Short: "shows a post", Query: "Post"},
+	}, commands)
+
+	commands, err = FindCLICommands("testdata/x/mars/client/none")
+	require.NoError(t, err)
+	require.Empty(t, commands)
+}
+
+func TestFindEventTypes(t *testing.T) {
+	eventTypes, err := FindEventTypes("testdata/x/mars/types")
+	require.NoError(t, err)
+	require.Equal(t, []EventType{
+		{Name: "EventTypePostCreated", Value: "post_created", Comment: "EventTypePostCreated is emitted when a post is created."},
+	}, eventTypes)
+}
+
+func TestModuleMarkdown(t *testing.T) {
+	m := Module{
+		Module: module.Module{
+			Name: "mars",
+			Pkg: protoanalysis.Package{
+				Name: "username.mars.mars",
+				Messages: []protoanalysis.Message{
+					{
+						Name:    "MsgCreatePost",
+						Comment: "MsgCreatePost creates a post.",
+						Fields: []protoanalysis.Field{
+							{Name: "creator", Number: 1, Type: "string"},
+							{Name: "title", Number: 2, Type: "string", Comment: "title of the | post"},
+						},
+					},
+					{Name: "MsgCreatePostResponse"},
+					{Name: "QueryGetPostRequest", Fields: []protoanalysis.Field{{Name: "id", Number: 1, Type: "uint64"}}},
+					{Name: "QueryGetPostResponse", Fields: []protoanalysis.Field{{Name: "post", Number: 1, Type: "Post"}}},
+					{Name: "EventPostLiked", Fields: []protoanalysis.Field{{Name: "likes", Number: 1, Type: "uint64", Repeated: true}}},
+					{Name: "Params"},
+					{
+						Name:    "GenesisState",
+						Comment: "GenesisState defines the mars module's genesis state.",
+						Fields:  []protoanalysis.Field{{Name: "post_list", Number: 1, Type: "Post", Repeated: true}},
+					},
+				},
+				Services: []protoanalysis.Service{
+					{
+						Name: "Msg",
+						RPCFuncs: []protoanalysis.RPCFunc{
+							{Name: "CreatePost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+						},
+					},
+					{
+						Name: "Query",
+						RPCFuncs: []protoanalysis.RPCFunc{
+							{
+								Name:        "Post",
+								RequestType: "QueryGetPostRequest",
+								ReturnsType: "QueryGetPostResponse",
+								Comment:     "Queries a post by id.",
+								HTTPRules: []protoanalysis.HTTPRule{
+									{Method: "GET", Endpoint: "/username/mars/mars/post/{id}", Params: []string{"id"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		BinaryName: "marsd",
+		Commands: []CLICommand{
+			{Use: "create-post [title] [body]", Msg: "MsgCreatePost"},
+			{Use: "show-post [id]", Query: "Post"},
+		},
+		EventTypes: []EventType{
+			{Name: "EventTypePostCreated", Value: "post_created", Comment: "EventTypePostCreated is emitted when a post is created."},
+		},
+	}
+
+	require.Equal(t, "# `mars` module\n\n"+
+		"Reference of the `mars` module generated from the `username.mars.mars` proto package.\n"+
+		"\n## Messages\n"+
+		"\n### MsgCreatePost\n\n"+
+		"MsgCreatePost creates a post.\n\n"+
+		"CLI: `marsd tx mars create-post [title] [body]`\n\n"+
+		"| Field | Type | Description |\n"+
+		"| ----- | ---- | ----------- |\n"+
+		"| `creator` | `string` |  |\n"+
+		"| `title` | `string` | title of the \\| post |\n"+
+		"\n## Queries\n"+
+		"\n### Post\n\n"+
+		"Queries a post by id.\n\n"+
+		"REST: `GET /username/mars/mars/post/{id}`\n\n"+
+		"CLI: `marsd query mars show-post [id]`\n\n"+
+		"Request `QueryGetPostRequest`:\n\n"+
+		"| Field | Type | Description |\n"+
+		"| ----- | ---- | ----------- |\n"+
+		"| `id` | `uint64` |  |\n"+
+		"\nResponse `QueryGetPostResponse`:\n\n"+
+		"| Field | Type | Description |\n"+
+		"| ----- | ---- | ----------- |\n"+
+		"| `post` | `Post` |  |\n"+
+		"\n## Events\n"+
+		"\n### EventPostLiked\n\n"+
+		"| Field | Type | Description |\n"+
+		"| ----- | ---- | ----------- |\n"+
+		"| `likes` | `repeated uint64` |  |\n"+
+		"\n### Event types\n\n"+
+		"| Type | Description |\n"+
+		"| ---- | ----------- |\n"+
+		"| `post_created` | EventTypePostCreated is emitted when a post is created. |\n"+
+		"\n## Params\n\n"+
+		"The module has no params.\n"+
+		"\n## Genesis\n\n"+
+		"GenesisState defines the mars module's genesis state.\n\n"+
+		"| Field | Type | Description |\n"+
+		"| ----- | ---- | ----------- |\n"+
+		"| `post_list` | `repeated Post` |  |\n", m.Markdown())
+}
@@ -0,0 +1,76 @@
+package cosmosdocs
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+)
+
+const eventTypeConstPrefix = "EventType"
+
+// EventType is an event type emitted by a module.
+type EventType struct {
+	// Name of the Go constant holding the event type.
+	Name string
+
+	// Value of the event type.
+	Value string
+
+	// Comment is the doc comment of the constant.
+	Comment string
+}
+
+// FindEventTypes finds the event types declared as EventType prefixed
+// string constants in the Go package at path.
+func FindEventTypes(path string) ([]EventType, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), path, nil, parser.ParseComments)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var eventTypes []EventType
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					vs := spec.(*ast.ValueSpec)
+
+					for i, name := range vs.Names {
+						if !strings.HasPrefix(name.Name, eventTypeConstPrefix) || i >= len(vs.Values) {
+							continue
+						}
+
+						value := stringLit(vs.Values[i])
+						if value == "" {
+							continue
+						}
+
+						eventTypes = append(eventTypes, EventType{
+							Name:    name.Name,
+							Value:   value,
+							Comment: strings.TrimSpace(vs.Doc.Text()),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	sort.Slice(eventTypes, func(i, j int) bool {
+		return eventTypes[i].Name < eventTypes[j].Name
+	})
+
+	return eventTypes, nil
+}
@@ -0,0 +1,8 @@
+package types
+
+const (
+	// EventTypePostCreated is emitted when a post is created.
+	EventTypePostCreated = "post_created"
+
+	AttributeKeyPostID = "post_id"
+)
@@ -0,0 +1,236 @@
+package cosmosfaucet
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRequestRateWindow is the default window that the request rate limits are applied for.
+const DefaultRequestRateWindow = time.Minute
+
+// IPRateLimit sets the max number of transfer requests that a single IP can make within
+// the request rate window.
+func IPRateLimit(limit int) Option {
+	return func(f *Faucet) {
+		f.ipRateLimit = limit
+	}
+}
+
+// GlobalRateLimit sets the max number of transfer requests that the faucet accepts from
+// all clients within the request rate window.
+func GlobalRateLimit(limit int) Option {
+	return func(f *Faucet) {
+		f.globalRateLimit = limit
+	}
+}
+
+// RequestRateWindow sets the window that the request rate limits are applied for.
+func RequestRateWindow(window time.Duration) Option {
+	return func(f *Faucet) {
+		f.requestRateWindow = window
+	}
+}
+
+// AllowAddresses only allows transfers to the given account addresses.
+func AllowAddresses(addresses ...string) Option {
+	return func(f *Faucet) {
+		f.access.allowAddresses = append(f.access.allowAddresses, addresses...)
+	}
+}
+
+// DenyAddresses denies transfers to the given account addresses.
+func DenyAddresses(addresses ...string) Option {
+	return func(f *Faucet) {
+		f.access.denyAddresses = append(f.access.denyAddresses, addresses...)
+	}
+}
+
+// AllowCIDRs only allows transfer requests from clients with an IP inside of the
+// given CIDR ranges. single IPs are also accepted.
+func AllowCIDRs(cidrs ...string) Option {
+	return func(f *Faucet) {
+		f.access.allowCIDRs = append(f.access.allowCIDRs, cidrs...)
+	}
+}
+
+// DenyCIDRs denies transfer requests from clients with an IP inside of the
+// given CIDR ranges. single IPs are also accepted.
+func DenyCIDRs(cidrs ...string) Option {
+	return func(f *Faucet) {
+		f.access.denyCIDRs = append(f.access.denyCIDRs, cidrs...)
+	}
+}
+
+// setupAbuseControls prepares the request rate limiters, access lists and proof-of-work
+// challenges configured by the options.
+func (f *Faucet) setupAbuseControls() error {
+	if f.requestRateWindow == 0 {
+		f.requestRateWindow = DefaultRequestRateWindow
+	}
+
+	if f.ipRateLimit > 0 {
+		f.ipLimiter = newRequestLimiter(f.ipRateLimit, f.requestRateWindow)
+	}
+
+	if f.globalRateLimit > 0 {
+		f.globalLimiter = newRequestLimiter(f.globalRateLimit, f.requestRateWindow)
+	}
+
+	if err := f.access.parse(); err != nil {
+		return err
+	}
+
+	if f.powDifficulty < 0 || f.powDifficulty > maxPoWDifficulty {
+		return fmt.Errorf("proof-of-work difficulty must be between 0 and %d", maxPoWDifficulty)
+	}
+
+	if f.powDifficulty > 0 {
+		f.challenges = newChallengeStore(f.powDifficulty, challengeTTL)
+	}
+
+	return nil
+}
+
+// requestLimiter limits the number of requests made for each key within a fixed window.
+type requestLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu        sync.Mutex
+	windows   map[string]requestWindow
+	lastPrune time.Time
+}
+
+type requestWindow struct {
+	start time.Time
+	count int
+}
+
+func newRequestLimiter(limit int, window time.Duration) *requestLimiter {
+	return &requestLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]requestWindow),
+	}
+}
+
+// allow reports whether a request can be made for key at now and counts it when so.
+func (l *requestLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// forget the keys that didn't make requests during the last window.
+	if now.Sub(l.lastPrune) >= l.window {
+		for k, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, k)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = requestWindow{start: now}
+	}
+
+	if w.count >= l.limit {
+		return false
+	}
+
+	w.count++
+	l.windows[key] = w
+
+	return true
+}
+
+// accessList allows or denies requests by account address and client IP.
+// when an allow list is not empty, only the listed ones are allowed.
+type accessList struct {
+	allowAddresses []string
+	denyAddresses  []string
+	allowCIDRs     []string
+	denyCIDRs      []string
+
+	allowNets []*net.IPNet
+	denyNets  []*net.IPNet
+}
+
+func (l *accessList) parse() (err error) {
+	if l.allowNets, err = parseCIDRs(l.allowCIDRs); err != nil {
+		return err
+	}
+	l.denyNets, err = parseCIDRs(l.denyCIDRs)
+	return err
+}
+
+// addressAllowed reports whether transfers can be sent to address.
+func (l accessList) addressAllowed(address string) bool {
+	if contains(l.denyAddresses, address) {
+		return false
+	}
+	return len(l.allowAddresses) == 0 || contains(l.allowAddresses, address)
+}
+
+// ipAllowed reports whether a client with ip can request transfers.
+func (l accessList) ipAllowed(ip net.IP) bool {
+	if ip == nil {
+		return len(l.allowNets) == 0
+	}
+	if containsIP(l.denyNets, ip) {
+		return false
+	}
+	return len(l.allowNets) == 0 || containsIP(l.allowNets, ip)
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+
+	for _, cidr := range cidrs {
+		// a single IP is a range with only one IP in it.
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP %q", cidr)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		nets = append(nets, ipNet)
+	}
+
+	return nets, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	var (
+		l   = newRequestLimiter(2, time.Minute)
+		now = time.Now()
+	)
+
+	require.True(t, l.allow("a", now))
+	require.True(t, l.allow("a", now))
+	require.False(t, l.allow("a", now))
+	require.True(t, l.allow("b", now))
+
+	// limit is refreshed for the next window.
+	require.True(t, l.allow("a", now.Add(time.Minute)))
+}
+
+func TestAccessList(t *testing.T) {
+	l := accessList{
+		denyAddresses: []string{addrDenied},
+		allowCIDRs:    []string{"10.0.0.0/8", "192.168.1.1"},
+		denyCIDRs:     []string{"10.0.0.1"},
+	}
+	require.NoError(t, l.parse())
+
+	require.True(t, l.addressAllowed(addrA))
+	require.False(t, l.addressAllowed(addrDenied))
+
+	require.True(t, l.ipAllowed(net.ParseIP("10.1.2.3")))
+	require.True(t, l.ipAllowed(net.ParseIP("192.168.1.1")))
+	require.False(t, l.ipAllowed(net.ParseIP("192.168.1.2")))
+	require.False(t, l.ipAllowed(net.ParseIP("10.0.0.1")))
+
+	l = accessList{allowAddresses: []string{addrA}, allowCIDRs: []string{"invalid"}}
+	require.Error(t, l.parse())
+	require.True(t, l.addressAllowed(addrA))
+	require.False(t, l.addressAllowed(addrB))
+}
+
+func TestChallengeStore(t *testing.T) {
+	var (
+		s   = newChallengeStore(8, time.Minute)
+		now = time.Now()
+	)
+
+	require.ErrorIs(t, s.verify("", "", now), ErrChallengeRequired)
+
+	challenge, _, err := s.issue(now)
+	require.NoError(t, err)
+
+	nonce, err := SolveChallenge(context.Background(), challenge, 8)
+	require.NoError(t, err)
+
+	// a challenge expires after the ttl.
+	require.ErrorIs(t, s.verify(challenge, nonce, now.Add(2*time.Minute)), ErrInvalidChallenge)
+
+	require.NoError(t, s.verify(challenge, nonce, now))
+
+	// a challenge can only be solved once.
+	require.ErrorIs(t, s.verify(challenge, nonce, now), ErrInvalidChallenge)
+}
+
+func TestFaucetHandlerAbuseControls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f := newTestFaucet(ctx, &broadcasterMock{})
+	f.powDifficulty = 8
+	f.ipRateLimit = 2
+	DenyAddresses(addrDenied)(&f)
+	require.NoError(t, f.setupAbuseControls())
+
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	// the client solves the challenge asked by the faucet.
+	res, err := c.Transfer(ctx, NewTransferRequest(addrA, nil))
+	require.NoError(t, err)
+	require.Empty(t, res.Error)
+
+	_, err = c.Transfer(ctx, NewTransferRequest(addrDenied, nil))
+	require.Equal(t, ErrTransferRequest{http.StatusForbidden}, err)
+
+	// denied requests don't count for the rate limits.
+	_, err = c.Transfer(ctx, NewTransferRequest(addrB, nil))
+	require.NoError(t, err)
+
+	_, err = c.Transfer(ctx, NewTransferRequest(addrC, nil))
+	require.Equal(t, ErrTransferRequest{http.StatusTooManyRequests}, err)
+}
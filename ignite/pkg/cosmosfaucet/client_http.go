@@ -29,7 +29,54 @@ func NewClient(addr string) HTTPClient {
 }
 
 // Transfer requests tokens from the faucet with req.
+// a proof-of-work challenge is solved when the faucet asks for it.
 func (c HTTPClient) Transfer(ctx context.Context, req TransferRequest) (TransferResponse, error) {
+	res, err := c.transfer(ctx, req)
+
+	var reqErr ErrTransferRequest
+	if req.Challenge != "" || !errors.As(err, &reqErr) || reqErr.StatusCode != http.StatusPreconditionRequired {
+		return res, err
+	}
+
+	challenge, err := c.Challenge(ctx)
+	if err != nil {
+		return TransferResponse{}, err
+	}
+
+	nonce, err := SolveChallenge(ctx, challenge.Challenge, challenge.Difficulty)
+	if err != nil {
+		return TransferResponse{}, err
+	}
+
+	req.Challenge = challenge.Challenge
+	req.Nonce = nonce
+
+	return c.transfer(ctx, req)
+}
+
+// Challenge requests a proof-of-work challenge to solve before requesting tokens.
+func (c HTTPClient) Challenge(ctx context.Context) (ChallengeResponse, error) {
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.addr+"/challenge", nil)
+	if err != nil {
+		return ChallengeResponse{}, err
+	}
+
+	hres, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return ChallengeResponse{}, err
+	}
+	defer hres.Body.Close()
+
+	if hres.StatusCode != http.StatusOK {
+		return ChallengeResponse{}, errors.New(http.StatusText(hres.StatusCode))
+	}
+
+	var res ChallengeResponse
+	err = json.NewDecoder(hres.Body).Decode(&res)
+	return res, err
+}
+
+func (c HTTPClient) transfer(ctx context.Context, req TransferRequest) (TransferResponse, error) {
 	data, err := json.Marshal(req)
 	if err != nil {
 		return TransferResponse{}, err
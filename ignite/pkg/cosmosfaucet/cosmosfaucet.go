@@ -3,11 +3,16 @@ package cosmosfaucet
 
 import (
 	"context"
+	"errors"
+	"path/filepath"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/xfilepath"
 )
 
 const (
@@ -28,8 +33,22 @@ const (
 	// DefaultLimitRefreshWindow specifies the time after which the max amount limit
 	// is refreshed for an account [1 year]
 	DefaultRefreshWindow = time.Hour * 24 * 365
+
+	// DefaultBatchWindow is the default duration to collect transfer requests
+	// into a batch before sending them with a single tx.
+	DefaultBatchWindow = time.Second
+
+	// DefaultMaxBatchSize is the default max number of transfers sent with a single tx.
+	DefaultMaxBatchSize = 100
+
+	// limitsFileName is the name of the file that persists the transfers of a chain by default.
+	limitsFileName = "limits.db"
 )
 
+// DefaultLimitsDir is the directory where the transfers sent by the faucets are persisted
+// by default, the transfers of each chain are kept under the directory named after the chain id.
+var DefaultLimitsDir = xfilepath.JoinFromHome(xfilepath.Path(".ignite"), xfilepath.Path("faucet"))
+
 // Faucet represents a faucet.
 type Faucet struct {
 	// runner used to intereact with blockchain's binary to transfer tokens.
@@ -41,6 +60,12 @@ type Faucet struct {
 	// accountName to transfer tokens from.
 	accountName string
 
+	// accountAddress is the address of the account.
+	accountAddress string
+
+	// accountPrefix is the bech32 prefix of the account addresses of the chain.
+	accountPrefix string
+
 	// accountMnemonic is the mnemonic of the account.
 	accountMnemonic string
 
@@ -56,6 +81,51 @@ type Faucet struct {
 
 	limitRefreshWindow time.Duration
 
+	// limitStore keeps the transfers sent to addresses to enforce the max amounts.
+	limitStore LimitStore
+
+	// ipRateLimit and globalRateLimit are the max number of transfer requests
+	// accepted from a single IP and from all clients within requestRateWindow.
+	ipRateLimit       int
+	globalRateLimit   int
+	requestRateWindow time.Duration
+
+	ipLimiter     *requestLimiter
+	globalLimiter *requestLimiter
+
+	// access allows or denies transfer requests by address and client IP.
+	access accessList
+
+	// powDifficulty is the difficulty of the proof-of-work challenges that
+	// clients must solve. challenges are disabled when it is zero.
+	powDifficulty int
+	challenges    *challengeStore
+
+	// metrics are the Prometheus metrics of the faucet.
+	metrics *metrics
+
+	// accessLogger writes the access log when set.
+	accessLogger *accessLogger
+
+	// adminToken authorizes the requests to the admin endpoints.
+	// admin endpoints are disabled when it is empty.
+	adminToken string
+
+	// broadcaster used to send transfer txs.
+	broadcaster TxBroadcaster
+
+	// batchWindow is the duration to collect transfer requests into a batch.
+	batchWindow time.Duration
+
+	// maxBatchSize is the max number of transfers sent with a single tx.
+	maxBatchSize int
+
+	// transfers queues the transfer requests to be batched.
+	transfers chan transferRequest
+
+	// stopped is closed once the faucet stops processing transfers.
+	stopped chan struct{}
+
 	// openAPIData holds template data customizations for serving OpenAPI page & spec.
 	openAPIData openAPIData
 }
@@ -93,6 +163,44 @@ func RefreshWindow(refreshWindow time.Duration) Option {
 	}
 }
 
+// Limits sets the store used to keep the transfers sent to addresses to enforce
+// the max amounts. transfers are persisted under DefaultLimitsDir by default so that
+// the limits survive restarts, use NewMemoryLimitStore to keep them in memory instead.
+func Limits(store LimitStore) Option {
+	return func(f *Faucet) {
+		f.limitStore = store
+	}
+}
+
+// AdminToken enables the admin endpoints that are authorized with token.
+func AdminToken(token string) Option {
+	return func(f *Faucet) {
+		f.adminToken = token
+	}
+}
+
+// Broadcaster sets the broadcaster used to send transfer txs signed by the faucet account.
+func Broadcaster(b TxBroadcaster) Option {
+	return func(f *Faucet) {
+		f.broadcaster = b
+	}
+}
+
+// BatchWindow sets the duration to collect transfer requests into a batch
+// before sending them with a single tx.
+func BatchWindow(window time.Duration) Option {
+	return func(f *Faucet) {
+		f.batchWindow = window
+	}
+}
+
+// MaxBatchSize sets the max number of transfers sent with a single tx.
+func MaxBatchSize(size int) Option {
+	return func(f *Faucet) {
+		f.maxBatchSize = size
+	}
+}
+
 // ChainID adds chain id to faucet. faucet will automatically fetch when it isn't provided.
 func ChainID(id string) Option {
 	return func(f *Faucet) {
@@ -108,18 +216,25 @@ func OpenAPI(apiAddress string) Option {
 }
 
 // New creates a new faucet with ccr (to access and use blockchain's CLI) and given options.
+// the faucet processes transfer requests until ctx is canceled.
 func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Faucet, error) {
 	f := Faucet{
-		runner:      ccr,
-		accountName: DefaultAccountName,
-		coinsMax:    make(map[string]uint64),
-		openAPIData: openAPIData{"Blockchain", "http://localhost:1317"},
+		runner:       ccr,
+		accountName:  DefaultAccountName,
+		coinsMax:     make(map[string]uint64),
+		batchWindow:  DefaultBatchWindow,
+		maxBatchSize: DefaultMaxBatchSize,
+		openAPIData:  openAPIData{"Blockchain", "http://localhost:1317"},
 	}
 
 	for _, apply := range options {
 		apply(&f)
 	}
 
+	if f.broadcaster == nil {
+		return Faucet{}, errors.New("a tx broadcaster is required to send transfers")
+	}
+
 	if len(f.coins) == 0 {
 		Coin(DefaultAmount, DefaultMaxAmount, DefaultDenom)(&f)
 	}
@@ -128,6 +243,10 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		RefreshWindow(DefaultRefreshWindow)(&f)
 	}
 
+	if err := f.setupAbuseControls(); err != nil {
+		return Faucet{}, err
+	}
+
 	// import the account if mnemonic is provided.
 	if f.accountMnemonic != "" {
 		_, err := f.runner.AddAccount(ctx, f.accountName, f.accountMnemonic, f.coinType)
@@ -136,6 +255,16 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		}
 	}
 
+	account, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return Faucet{}, err
+	}
+	f.accountAddress = account.Address
+
+	if f.accountPrefix, _, err = bech32.DecodeAndConvert(account.Address); err != nil {
+		return Faucet{}, err
+	}
+
 	if f.chainID == "" {
 		status, err := f.runner.Status(ctx)
 		if err != nil {
@@ -146,5 +275,44 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		f.openAPIData.ChainID = status.ChainID
 	}
 
+	if f.limitStore == nil {
+		if f.limitStore, err = defaultLimitStore(f.chainID); err != nil {
+			return Faucet{}, err
+		}
+	}
+
+	f.metrics = newMetrics(f.chainID)
+	f.start(ctx)
+
 	return f, nil
 }
+
+// defaultLimitStore creates the limit store that persists the transfers of the chain under DefaultLimitsDir.
+func defaultLimitStore(chainID string) (LimitStore, error) {
+	dir, err := DefaultLimitsDir()
+	if err != nil {
+		return nil, err
+	}
+	storage, err := cache.NewStorage(filepath.Join(dir, chainID, limitsFileName))
+	if err != nil {
+		return nil, err
+	}
+	return NewCacheLimitStore(storage), nil
+}
+
+// ChainID returns the id of the chain that the faucet is operating for.
+func (f Faucet) ChainID() string {
+	return f.chainID
+}
+
+// start starts processing transfer requests until ctx is canceled.
+func (f *Faucet) start(ctx context.Context) {
+	if f.maxBatchSize < 1 {
+		f.maxBatchSize = 1
+	}
+
+	f.transfers = make(chan transferRequest)
+	f.stopped = make(chan struct{})
+
+	go f.processTransfers(ctx, f.stopped)
+}
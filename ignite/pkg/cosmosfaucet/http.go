@@ -20,11 +20,27 @@ func (f Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	router.Handle("/info", cors.Default().Handler(http.HandlerFunc(f.faucetInfoHandler))).
 		Methods(http.MethodGet)
 
+	if f.challenges != nil {
+		router.Handle("/challenge", cors.Default().Handler(http.HandlerFunc(f.challengeHandler))).
+			Methods(http.MethodGet)
+	}
+
 	router.HandleFunc("/", openapiconsole.Handler("Faucet", "openapi.yml")).
 		Methods(http.MethodGet)
 
 	router.HandleFunc("/openapi.yml", f.openAPISpecHandler).
 		Methods(http.MethodGet)
 
-	router.ServeHTTP(w, r)
+	router.Handle("/metrics", f.metricsHandler()).
+		Methods(http.MethodGet)
+
+	if f.adminToken != "" {
+		router.HandleFunc("/admin/usage/{address}", f.adminHandler(f.usageHandler)).
+			Methods(http.MethodGet)
+
+		router.HandleFunc("/admin/usage/{address}", f.adminHandler(f.resetUsageHandler)).
+			Methods(http.MethodDelete)
+	}
+
+	f.logRequests(router).ServeHTTP(w, r)
 }
@@ -0,0 +1,85 @@
+package cosmosfaucet
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ignite-hq/cli/ignite/pkg/xhttp"
+)
+
+const (
+	// adminAuthScheme is the authorization scheme of admin requests.
+	adminAuthScheme = "Bearer "
+
+	routeVarAddress = "address"
+)
+
+// UsageResponse is the payload of an address' usage of the faucet.
+type UsageResponse struct {
+	// Address that the usage belongs to.
+	Address string `json:"address"`
+
+	// Coins is the total amount of coins sent to the address within the refresh window.
+	Coins []string `json:"coins"`
+
+	// Transfers are the transfers sent to the address within the refresh window by denom.
+	Transfers Usage `json:"transfers"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// adminHandler authorizes admin requests before passing them to next.
+func (f Faucet) adminHandler(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(auth, adminAuthScheme)
+
+		if !strings.HasPrefix(auth, adminAuthScheme) ||
+			subtle.ConstantTimeCompare([]byte(token), []byte(f.adminToken)) != 1 {
+			xhttp.ResponseJSON(w, http.StatusUnauthorized, UsageResponse{
+				Error: http.StatusText(http.StatusUnauthorized),
+			})
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (f Faucet) usageHandler(w http.ResponseWriter, r *http.Request) {
+	address := mux.Vars(r)[routeVarAddress]
+
+	usage, err := f.Usage(address)
+	if err != nil {
+		xhttp.ResponseJSON(w, http.StatusInternalServerError, UsageResponse{Error: err.Error()})
+		return
+	}
+
+	var coins []string
+	for _, c := range usage.Coins(f.limitWindowStart()) {
+		coins = append(coins, c.String())
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, UsageResponse{
+		Address:   address,
+		Coins:     coins,
+		Transfers: usage,
+	})
+}
+
+func (f Faucet) resetUsageHandler(w http.ResponseWriter, r *http.Request) {
+	address := mux.Vars(r)[routeVarAddress]
+
+	if err := f.ResetUsage(address); err != nil {
+		xhttp.ResponseJSON(w, http.StatusInternalServerError, UsageResponse{Error: err.Error()})
+		return
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, UsageResponse{
+		Address:   address,
+		Transfers: Usage{},
+	})
+}
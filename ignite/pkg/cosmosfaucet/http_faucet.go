@@ -3,13 +3,25 @@ package cosmosfaucet
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ignite-hq/cli/ignite/pkg/xhttp"
 )
 
+var (
+	errIPNotAllowed      = errors.New("requests from your IP are not allowed")
+	errAddressNotAllowed = errors.New("transfers to this address are not allowed")
+	errIPRateLimited     = errors.New("too many requests from your IP, try again later")
+	errGlobalRateLimited = errors.New("faucet is receiving too many requests, try again later")
+)
+
 type TransferRequest struct {
 	// AccountAddress to request for coins.
 	AccountAddress string `json:"address"`
@@ -17,6 +29,13 @@ type TransferRequest struct {
 	// Coins that are requested.
 	// default ones used when this one isn't provided.
 	Coins []string `json:"coins"`
+
+	// Challenge is the proof-of-work challenge solved by the client.
+	// only required when the faucet asks for proof-of-work.
+	Challenge string `json:"challenge,omitempty"`
+
+	// Nonce solves the challenge.
+	Nonce string `json:"nonce,omitempty"`
 }
 
 func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
@@ -31,32 +50,121 @@ type TransferResponse struct {
 }
 
 func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
-	var req TransferRequest
+	var (
+		req   TransferRequest
+		now   = time.Now()
+		ip    = clientIP(r)
+		entry = accessLogEntry(r.Context())
+	)
+
+	entry.transfer = true
+
+	// check the client before decoding the request.
+	if !f.access.ipAllowed(ip) {
+		transferError(w, entry, http.StatusForbidden, errIPNotAllowed)
+		return
+	}
 
 	// decode request into req.
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		responseError(w, http.StatusBadRequest, err)
+		transferError(w, entry, http.StatusBadRequest, err)
+		return
+	}
+
+	entry.Address = req.AccountAddress
+
+	if !f.access.addressAllowed(req.AccountAddress) {
+		transferError(w, entry, http.StatusForbidden, errAddressNotAllowed)
+		return
+	}
+
+	if f.challenges != nil {
+		if err := f.challenges.verify(req.Challenge, req.Nonce, now); err != nil {
+			code := http.StatusForbidden
+			if err == ErrChallengeRequired {
+				code = http.StatusPreconditionRequired
+			}
+			transferError(w, entry, code, err)
+			return
+		}
+	}
+
+	// only the requests that can lead to a transfer count for the rate limits.
+	if f.ipLimiter != nil && !f.ipLimiter.allow(ip.String(), now) {
+		transferError(w, entry, http.StatusTooManyRequests, errIPRateLimited)
+		return
+	}
+
+	if f.globalLimiter != nil && !f.globalLimiter.allow("", now) {
+		transferError(w, entry, http.StatusTooManyRequests, errGlobalRateLimited)
 		return
 	}
 
 	// determine coins to transfer.
 	coins, err := f.coinsFromRequest(req)
 	if err != nil {
-		responseError(w, http.StatusBadRequest, err)
+		transferError(w, entry, http.StatusBadRequest, err)
 		return
 	}
 
+	entry.Coins = coins.String()
+
 	// try performing the transfer
 	if err := f.Transfer(r.Context(), req.AccountAddress, coins); err != nil {
 		if err == context.Canceled {
+			entry.Error = err.Error()
 			return
 		}
-		responseError(w, http.StatusInternalServerError, err)
+		if errors.Is(err, ErrInvalidAddress) {
+			transferError(w, entry, http.StatusBadRequest, err)
+			return
+		}
+		transferError(w, entry, http.StatusInternalServerError, err)
 	} else {
 		responseSuccess(w)
 	}
 }
 
+// transferError responds to a transfer request with err and adds it to the access log entry.
+func transferError(w http.ResponseWriter, entry *AccessLogEntry, code int, err error) {
+	entry.Error = err.Error()
+	responseError(w, code, err)
+}
+
+// ChallengeResponse is a proof-of-work challenge to solve before requesting tokens.
+type ChallengeResponse struct {
+	// Challenge to solve.
+	Challenge string `json:"challenge"`
+
+	// Difficulty is the number of leading zero bits required in the SHA-256 hash of
+	// the challenge concatenated with the nonce.
+	Difficulty int `json:"difficulty"`
+
+	// ExpiresAt is the time that the challenge must be solved before.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	Error string `json:"error,omitempty"`
+}
+
+func (f Faucet) challengeHandler(w http.ResponseWriter, r *http.Request) {
+	if !f.access.ipAllowed(clientIP(r)) {
+		xhttp.ResponseJSON(w, http.StatusForbidden, ChallengeResponse{Error: errIPNotAllowed.Error()})
+		return
+	}
+
+	challenge, expiresAt, err := f.challenges.issue(time.Now())
+	if err != nil {
+		xhttp.ResponseJSON(w, http.StatusServiceUnavailable, ChallengeResponse{Error: err.Error()})
+		return
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, ChallengeResponse{
+		Challenge:  challenge,
+		Difficulty: f.powDifficulty,
+		ExpiresAt:  expiresAt,
+	})
+}
+
 // FaucetInfoResponse is the faucet info payload.
 type FaucetInfoResponse struct {
 	// IsAFaucet indicates that this is a faucet endpoint.
@@ -64,13 +172,37 @@ type FaucetInfoResponse struct {
 	IsAFaucet bool `json:"is_a_faucet"`
 
 	// ChainID is chain id of the chain that faucet is running for.
+	// it is empty when multiple chains are served.
 	ChainID string `json:"chain_id"`
+
+	// Chains are the ids of the chains that tokens can be requested for.
+	// when multiple chains are served, the faucet of each chain is routed by its chain id.
+	Chains []string `json:"chains,omitempty"`
+}
+
+// ChainURL returns the URL of the faucet for the chain with chainID from the URL of
+// the faucet that returned the info. ok is false when the chain isn't served.
+func (i FaucetInfoResponse) ChainURL(faucetURL *url.URL, chainID string) (u *url.URL, ok bool) {
+	if i.ChainID != "" {
+		return faucetURL, i.ChainID == chainID
+	}
+
+	for _, id := range i.Chains {
+		if id == chainID {
+			u := *faucetURL
+			u.Path = strings.TrimSuffix(u.Path, "/") + ChainPath(chainID)
+			return &u, true
+		}
+	}
+
+	return nil, false
 }
 
 func (f Faucet) faucetInfoHandler(w http.ResponseWriter, r *http.Request) {
 	xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
 		IsAFaucet: true,
 		ChainID:   f.chainID,
+		Chains:    []string{f.chainID},
 	})
 }
 
@@ -92,6 +224,15 @@ func (f Faucet) coinsFromRequest(req TransferRequest) (sdk.Coins, error) {
 	return coins, nil
 }
 
+// clientIP returns the IP of the client that made r.
+func clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
 func responseSuccess(w http.ResponseWriter) {
 	xhttp.ResponseJSON(w, http.StatusOK, TransferResponse{})
 }
@@ -0,0 +1,112 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type accessLogEntryKey struct{}
+
+// AccessLogEntry is a structured access log entry of a request to the faucet.
+type AccessLogEntry struct {
+	Time       time.Time `json:"time"`
+	ChainID    string    `json:"chain_id"`
+	RemoteIP   string    `json:"remote_ip"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Status     int       `json:"status"`
+	DurationMS int64     `json:"duration_ms"`
+
+	// Outcome, Address and Coins are only set for transfer requests.
+	Outcome string `json:"outcome,omitempty"`
+	Address string `json:"address,omitempty"`
+	Coins   string `json:"coins,omitempty"`
+
+	Error string `json:"error,omitempty"`
+
+	// transfer is true for transfer requests.
+	transfer bool
+}
+
+// accessLogger writes access log entries as JSON lines.
+type accessLogger struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// AccessLog writes a JSON access log entry for each request to the faucet into w.
+func AccessLog(w io.Writer) Option {
+	return func(f *Faucet) {
+		f.accessLogger = &accessLogger{enc: json.NewEncoder(w)}
+	}
+}
+
+func (l *accessLogger) log(entry *AccessLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// logging is best effort and must not fail requests.
+	_ = l.enc.Encode(entry)
+}
+
+// statusRecorder records the status code of a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logRequests counts the outcomes of the transfer requests and writes an access
+// log entry for each request served by next.
+func (f Faucet) logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			start = time.Now()
+			rec   = &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			entry = &AccessLogEntry{
+				Time:     start,
+				ChainID:  f.chainID,
+				RemoteIP: clientIP(r).String(),
+				Method:   r.Method,
+				Path:     r.URL.Path,
+			}
+		)
+
+		next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), accessLogEntryKey{}, entry)))
+
+		entry.Status = rec.status
+		entry.DurationMS = time.Since(start).Milliseconds()
+
+		if entry.transfer {
+			entry.Outcome = requestOutcome(rec.status)
+
+			// requests canceled by the clients don't get a response.
+			if entry.Outcome == OutcomeSuccess && entry.Error != "" {
+				entry.Outcome = OutcomeError
+			}
+
+			f.metrics.requests.WithLabelValues(entry.Outcome).Inc()
+		}
+
+		if f.accessLogger != nil {
+			f.accessLogger.log(entry)
+		}
+	})
+}
+
+// accessLogEntry returns the access log entry of the request with ctx.
+// a discarded entry is returned when the request isn't logged.
+func accessLogEntry(ctx context.Context) *AccessLogEntry {
+	if entry, ok := ctx.Value(accessLogEntryKey{}).(*AccessLogEntry); ok {
+		return entry
+	}
+	return &AccessLogEntry{}
+}
@@ -0,0 +1,154 @@
+package cosmosfaucet
+
+import (
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+)
+
+const limitCacheNamespace = "faucet.limits"
+
+// TransferRecord is an amount transferred to an address.
+type TransferRecord struct {
+	// Amount of the transferred coin.
+	Amount uint64 `json:"amount"`
+
+	// Time is when the transfer is sent.
+	Time time.Time `json:"time"`
+}
+
+// Usage holds the transfers sent to an address for each denom.
+type Usage map[string][]TransferRecord
+
+// Total returns the total amount of denom transferred since t.
+func (u Usage) Total(denom string, since time.Time) (total uint64) {
+	for _, r := range u[denom] {
+		if r.Time.After(since) {
+			total += r.Amount
+		}
+	}
+	return total
+}
+
+// Coins returns the total amount of coins transferred since t.
+func (u Usage) Coins(since time.Time) sdk.Coins {
+	coins := sdk.NewCoins()
+	for denom := range u {
+		coins = coins.Add(sdk.NewCoin(denom, sdk.NewIntFromUint64(u.Total(denom, since))))
+	}
+	return coins
+}
+
+// add records the coins transferred at t and drops the transfers before since.
+func (u Usage) add(coins sdk.Coins, t, since time.Time) {
+	for denom, records := range u {
+		var kept []TransferRecord
+		for _, r := range records {
+			if r.Time.After(since) {
+				kept = append(kept, r)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(u, denom)
+		} else {
+			u[denom] = kept
+		}
+	}
+
+	for _, c := range coins {
+		u[c.Denom] = append(u[c.Denom], TransferRecord{
+			Amount: c.Amount.Uint64(),
+			Time:   t,
+		})
+	}
+}
+
+// LimitStore stores the transfers sent to addresses, it is used to enforce
+// the max amounts that can be sent to a single address.
+type LimitStore interface {
+	// Usage returns the transfers sent to address.
+	// an empty usage is returned when nothing is sent to address.
+	Usage(address string) (Usage, error)
+
+	// SetUsage saves the transfers sent to address.
+	SetUsage(address string, usage Usage) error
+
+	// ResetUsage deletes the transfers sent to address.
+	ResetUsage(address string) error
+}
+
+type cacheLimitStore struct {
+	cache cache.Cache[Usage]
+}
+
+// NewCacheLimitStore creates a limit store that persists transfers to storage.
+func NewCacheLimitStore(storage cache.Storage) LimitStore {
+	return cacheLimitStore{
+		cache: cache.New[Usage](storage, limitCacheNamespace),
+	}
+}
+
+func (s cacheLimitStore) Usage(address string) (Usage, error) {
+	usage, err := s.cache.Get(address)
+	if err == cache.ErrorNotFound {
+		return make(Usage), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if usage == nil {
+		usage = make(Usage)
+	}
+	return usage, nil
+}
+
+func (s cacheLimitStore) SetUsage(address string, usage Usage) error {
+	return s.cache.Put(address, usage)
+}
+
+func (s cacheLimitStore) ResetUsage(address string) error {
+	return s.cache.Delete(address)
+}
+
+type memoryLimitStore struct {
+	mu     sync.Mutex
+	usages map[string]Usage
+}
+
+// NewMemoryLimitStore creates a limit store that keeps transfers in memory.
+func NewMemoryLimitStore() LimitStore {
+	return &memoryLimitStore{
+		usages: make(map[string]Usage),
+	}
+}
+
+func (s *memoryLimitStore) Usage(address string) (Usage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage := make(Usage)
+	for denom, records := range s.usages[address] {
+		usage[denom] = append([]TransferRecord{}, records...)
+	}
+	return usage, nil
+}
+
+func (s *memoryLimitStore) SetUsage(address string, usage Usage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.usages[address] = usage
+	return nil
+}
+
+func (s *memoryLimitStore) ResetUsage(address string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.usages, address)
+	return nil
+}
@@ -0,0 +1,50 @@
+package cosmosfaucet_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+)
+
+func TestCacheLimitStore(t *testing.T) {
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	store := cosmosfaucet.NewCacheLimitStore(storage)
+
+	usage, err := store.Usage("cosmos1a")
+	require.NoError(t, err)
+	require.Empty(t, usage)
+
+	var (
+		now   = time.Now().UTC()
+		since = now.Add(-time.Hour)
+	)
+
+	usage = cosmosfaucet.Usage{
+		"token": {
+			{Amount: 5, Time: now.Add(-2 * time.Hour)},
+			{Amount: 10, Time: now},
+		},
+		"stake": {{Amount: 1, Time: now}},
+	}
+	require.NoError(t, store.SetUsage("cosmos1a", usage))
+
+	usage, err = store.Usage("cosmos1a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), usage.Total("token", since))
+	require.Equal(t, uint64(15), usage.Total("token", time.Time{}))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("token", 10)), usage.Coins(since))
+
+	require.NoError(t, store.ResetUsage("cosmos1a"))
+
+	usage, err = store.Usage("cosmos1a")
+	require.NoError(t, err)
+	require.Empty(t, usage)
+}
@@ -0,0 +1,141 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace = "faucet"
+	labelChainID     = "chain_id"
+	labelOutcome     = "outcome"
+	labelDenom       = "denom"
+)
+
+// Outcomes of the transfer requests.
+const (
+	OutcomeSuccess           = "success"
+	OutcomeBadRequest        = "bad_request"
+	OutcomeForbidden         = "forbidden"
+	OutcomeChallengeRequired = "challenge_required"
+	OutcomeRateLimited       = "rate_limited"
+	OutcomeError             = "error"
+)
+
+// balanceQueryTimeout is the max duration to query the balances of the faucet account
+// when the metrics are scraped.
+const balanceQueryTimeout = 5 * time.Second
+
+// BalanceQuerier queries the balances of accounts. when the broadcaster of a faucet
+// implements it, the balances of the faucet account are exposed as metrics.
+type BalanceQuerier interface {
+	// Balances returns the balances of the account with address.
+	Balances(ctx context.Context, address string) (sdk.Coins, error)
+}
+
+// metrics are the Prometheus metrics of a faucet.
+type metrics struct {
+	registry *prometheus.Registry
+
+	requests    *prometheus.CounterVec
+	distributed *prometheus.CounterVec
+	balance     *prometheus.GaugeVec
+	txDuration  prometheus.Histogram
+	queueDepth  prometheus.Gauge
+}
+
+func newMetrics(chainID string) *metrics {
+	labels := prometheus.Labels{labelChainID: chainID}
+
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Name:        "requests_total",
+			Help:        "Number of transfer requests by outcome.",
+			ConstLabels: labels,
+		}, []string{labelOutcome}),
+		distributed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Name:        "distributed_amount_total",
+			Help:        "Amount of coins distributed by denom.",
+			ConstLabels: labels,
+		}, []string{labelDenom}),
+		balance: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   metricsNamespace,
+			Name:        "account_balance",
+			Help:        "Remaining balance of the faucet account by denom.",
+			ConstLabels: labels,
+		}, []string{labelDenom}),
+		txDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace:   metricsNamespace,
+			Name:        "tx_duration_seconds",
+			Help:        "Time to broadcast a transfer tx and get it included in a block.",
+			ConstLabels: labels,
+			Buckets:     []float64{0.5, 1, 2, 5, 10, 20, 30, 60},
+		}),
+		queueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   metricsNamespace,
+			Name:        "queue_depth",
+			Help:        "Number of transfer requests waiting to be sent.",
+			ConstLabels: labels,
+		}),
+	}
+
+	m.registry.MustRegister(m.requests, m.distributed, m.balance, m.txDuration, m.queueDepth)
+
+	return m
+}
+
+// requestOutcome returns the outcome of a transfer request from its response status code.
+func requestOutcome(statusCode int) string {
+	switch statusCode {
+	case http.StatusOK:
+		return OutcomeSuccess
+	case http.StatusBadRequest:
+		return OutcomeBadRequest
+	case http.StatusForbidden, http.StatusUnauthorized:
+		return OutcomeForbidden
+	case http.StatusPreconditionRequired:
+		return OutcomeChallengeRequired
+	case http.StatusTooManyRequests:
+		return OutcomeRateLimited
+	default:
+		return OutcomeError
+	}
+}
+
+// updateBalance sets the balance metrics to the current balances of the faucet account.
+func (f Faucet) updateBalance(ctx context.Context) {
+	querier, ok := f.broadcaster.(BalanceQuerier)
+	if !ok {
+		return
+	}
+
+	balances, err := querier.Balances(ctx, f.accountAddress)
+	if err != nil {
+		return
+	}
+
+	for _, c := range f.coins {
+		f.metrics.balance.WithLabelValues(c.Denom).Set(float64(balances.AmountOf(c.Denom).Uint64()))
+	}
+}
+
+// metricsHandler serves the metrics, the balance metrics are refreshed on each scrape
+// so that they stay accurate while the faucet is idle or the account is used by others.
+func (f Faucet) metricsHandler() http.Handler {
+	h := promhttp.HandlerFor(f.metrics.registry, promhttp.HandlerOpts{})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), balanceQueryTimeout)
+		f.updateBalance(ctx)
+		cancel()
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,111 @@
+package cosmosfaucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type balanceBroadcasterMock struct {
+	broadcasterMock
+	balance int64
+}
+
+func (b *balanceBroadcasterMock) Balances(context.Context, string) (sdk.Coins, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return sdk.NewCoins(sdk.NewInt64Coin("token", b.balance)), nil
+}
+
+func (b *balanceBroadcasterMock) setBalance(balance int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.balance = balance
+}
+
+func TestMetricsAndAccessLog(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		accessLog bytes.Buffer
+		f         = newTestFaucet(ctx, &balanceBroadcasterMock{balance: 95})
+	)
+	AccessLog(&accessLog)(&f)
+
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	_, err := c.Transfer(ctx, NewTransferRequest(addrA, nil))
+	require.NoError(t, err)
+
+	_, err = c.Transfer(ctx, NewTransferRequest(addrA, []string{"1foo"}))
+	require.Equal(t, ErrTransferRequest{http.StatusInternalServerError}, err)
+
+	res, err := http.Get(srv.URL + "/metrics")
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	metrics, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(metrics), `faucet_requests_total{chain_id="",outcome="success"} 1`)
+	require.Contains(t, string(metrics), `faucet_requests_total{chain_id="",outcome="error"} 1`)
+	require.Contains(t, string(metrics), `faucet_distributed_amount_total{chain_id="",denom="token"} 5`)
+	require.Contains(t, string(metrics), `faucet_account_balance{chain_id="",denom="token"} 95`)
+	require.Contains(t, string(metrics), `faucet_tx_duration_seconds_count{chain_id=""} 1`)
+	require.Contains(t, string(metrics), `faucet_queue_depth{chain_id=""} 0`)
+
+	var entries []AccessLogEntry
+	dec := json.NewDecoder(&accessLog)
+	for dec.More() {
+		var entry AccessLogEntry
+		require.NoError(t, dec.Decode(&entry))
+		entries = append(entries, entry)
+	}
+
+	require.Len(t, entries, 3)
+	require.Equal(t, http.StatusOK, entries[0].Status)
+	require.Equal(t, OutcomeSuccess, entries[0].Outcome)
+	require.Equal(t, addrA, entries[0].Address)
+	require.Equal(t, "10stake,5token", entries[0].Coins)
+	require.Equal(t, OutcomeError, entries[1].Outcome)
+	require.Equal(t, `"foo" denom is not distributed by the faucet`, entries[1].Error)
+	require.Equal(t, "/metrics", entries[2].Path)
+	require.Empty(t, entries[2].Outcome)
+}
+
+func TestMetricsBalanceRefreshedOnScrape(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := &balanceBroadcasterMock{balance: 100}
+	f := newTestFaucet(ctx, b)
+
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	scrape := func() string {
+		res, err := http.Get(srv.URL + "/metrics")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		metrics, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(metrics)
+	}
+
+	require.Contains(t, scrape(), `faucet_account_balance{chain_id="",denom="token"} 100`)
+
+	// the account is drained from outside while the faucet is idle.
+	b.setBalance(40)
+	require.Contains(t, scrape(), `faucet_account_balance{chain_id="",denom="token"} 40`)
+}
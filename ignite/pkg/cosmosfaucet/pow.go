@@ -0,0 +1,139 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/bits"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// maxPoWDifficulty is the max number of leading zero bits that can be required
+	// from challenge solutions.
+	maxPoWDifficulty = 32
+
+	// challengeTTL is the duration that a challenge can be solved within.
+	challengeTTL = 5 * time.Minute
+
+	// maxPendingChallenges is the max number of challenges that can wait to be solved.
+	maxPendingChallenges = 10000
+)
+
+var (
+	// ErrChallengeRequired is returned when a transfer is requested without solving a challenge.
+	ErrChallengeRequired = errors.New("a proof-of-work challenge must be solved before requesting tokens")
+
+	// ErrInvalidChallenge is returned when a challenge is unknown, expired or wrongly solved.
+	ErrInvalidChallenge = errors.New("proof-of-work challenge is invalid, expired or not solved")
+
+	// ErrTooManyChallenges is returned when too many challenges are waiting to be solved.
+	ErrTooManyChallenges = errors.New("too many pending challenges, try again later")
+)
+
+// ProofOfWork requires clients to solve a challenge before requesting transfers.
+// difficulty is the number of leading zero bits required in the hash of a solution.
+func ProofOfWork(difficulty int) Option {
+	return func(f *Faucet) {
+		f.powDifficulty = difficulty
+	}
+}
+
+// challengeStore keeps the issued challenges until they're solved or expired.
+type challengeStore struct {
+	difficulty int
+	ttl        time.Duration
+
+	mu      sync.Mutex
+	pending map[string]time.Time
+}
+
+func newChallengeStore(difficulty int, ttl time.Duration) *challengeStore {
+	return &challengeStore{
+		difficulty: difficulty,
+		ttl:        ttl,
+		pending:    make(map[string]time.Time),
+	}
+}
+
+// issue creates a new challenge that expires after the ttl.
+func (s *challengeStore) issue(now time.Time) (challenge string, expiresAt time.Time, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for c, exp := range s.pending {
+		if now.After(exp) {
+			delete(s.pending, c)
+		}
+	}
+
+	if len(s.pending) >= maxPendingChallenges {
+		return "", time.Time{}, ErrTooManyChallenges
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+
+	challenge = hex.EncodeToString(b)
+	expiresAt = now.Add(s.ttl)
+	s.pending[challenge] = expiresAt
+
+	return challenge, expiresAt, nil
+}
+
+// verify checks that nonce solves the challenge, a challenge can only be used once.
+func (s *challengeStore) verify(challenge, nonce string, now time.Time) error {
+	if challenge == "" {
+		return ErrChallengeRequired
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exp, ok := s.pending[challenge]
+	if !ok || now.After(exp) || !challengeSolved(challenge, nonce, s.difficulty) {
+		return ErrInvalidChallenge
+	}
+
+	delete(s.pending, challenge)
+
+	return nil
+}
+
+// SolveChallenge finds a nonce that solves challenge with difficulty.
+func SolveChallenge(ctx context.Context, challenge string, difficulty int) (string, error) {
+	for n := uint64(0); ; n++ {
+		// check for cancellation once in a while without slowing down hashing.
+		if n%4096 == 0 && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
+		nonce := strconv.FormatUint(n, 10)
+		if challengeSolved(challenge, nonce, difficulty) {
+			return nonce, nil
+		}
+	}
+}
+
+// challengeSolved reports whether the hash of challenge and nonce has at least
+// difficulty leading zero bits.
+func challengeSolved(challenge, nonce string, difficulty int) bool {
+	sum := sha256.Sum256([]byte(challenge + nonce))
+
+	var zeros int
+	for _, b := range sum {
+		if b != 0 {
+			zeros += bits.LeadingZeros8(b)
+			break
+		}
+		zeros += 8
+	}
+
+	return zeros >= difficulty
+}
@@ -0,0 +1,127 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/cors"
+
+	"github.com/ignite-hq/cli/ignite/pkg/xhttp"
+)
+
+const (
+	// chainsPath is the path that the faucets of a server are routed under.
+	chainsPath = "/chains/"
+
+	routeVarChainID = "id"
+)
+
+// Server serves the faucets of multiple chains from a single HTTP server.
+// requests to a faucet are routed by its chain id under /chains/{id}.
+type Server struct {
+	faucets  map[string]Faucet
+	chainIDs []string
+}
+
+// NewServer creates a server for faucets, each faucet must operate for a different chain.
+func NewServer(faucets ...Faucet) (Server, error) {
+	s := Server{
+		faucets: make(map[string]Faucet),
+	}
+
+	for _, f := range faucets {
+		if _, ok := s.faucets[f.chainID]; ok {
+			return Server{}, fmt.Errorf("multiple faucets for chain %q", f.chainID)
+		}
+
+		s.faucets[f.chainID] = f
+		s.chainIDs = append(s.chainIDs, f.chainID)
+	}
+
+	return s, nil
+}
+
+// ChainPath returns the path of the faucet for the chain with chainID.
+func ChainPath(chainID string) string {
+	return chainsPath + chainID
+}
+
+// ServeHTTP implements http.Handler to route requests to the faucets.
+func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	router := mux.NewRouter()
+
+	router.Handle("/info", cors.Default().Handler(http.HandlerFunc(s.infoHandler))).
+		Methods(http.MethodGet)
+
+	router.Handle("/metrics", s.metricsHandler()).
+		Methods(http.MethodGet)
+
+	router.PathPrefix(ChainPath("{" + routeVarChainID + "}")).
+		HandlerFunc(s.chainHandler)
+
+	router.ServeHTTP(w, r)
+}
+
+func (s Server) infoHandler(w http.ResponseWriter, r *http.Request) {
+	xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
+		IsAFaucet: true,
+		Chains:    s.chainIDs,
+	})
+}
+
+// metricsHandler serves the metrics of all faucets, the balance metrics of each faucet
+// are refreshed on each scrape like for a single faucet.
+func (s Server) metricsHandler() http.Handler {
+	var gatherers prometheus.Gatherers
+	for _, id := range s.chainIDs {
+		gatherers = append(gatherers, s.faucets[id].metrics.registry)
+	}
+	h := promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), balanceQueryTimeout)
+		defer cancel()
+
+		// the balances are queried concurrently so a slow chain doesn't delay the others.
+		var wg sync.WaitGroup
+		for _, f := range s.faucets {
+			f := f
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				f.updateBalance(ctx)
+			}()
+		}
+		wg.Wait()
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// chainHandler passes the request to the faucet of the chain without the chain's path.
+func (s Server) chainHandler(w http.ResponseWriter, r *http.Request) {
+	chainID := mux.Vars(r)[routeVarChainID]
+
+	f, ok := s.faucets[chainID]
+	if !ok {
+		responseError(w, http.StatusNotFound, fmt.Errorf("no faucet for chain %q", chainID))
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, ChainPath(chainID))
+	if path == "" {
+		path = "/"
+	}
+
+	r = r.Clone(r.Context())
+	r.URL.Path = path
+	r.URL.RawPath = ""
+
+	f.ServeHTTP(w, r)
+}
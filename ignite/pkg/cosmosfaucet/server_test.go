@@ -0,0 +1,109 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		marsBroadcaster  = &broadcasterMock{}
+		venusBroadcaster = &broadcasterMock{}
+		mars             = newTestFaucet(ctx, marsBroadcaster)
+		venus            = newTestFaucet(ctx, venusBroadcaster)
+	)
+	mars.chainID, mars.metrics = "mars", newMetrics("mars")
+	venus.chainID, venus.metrics = "venus", newMetrics("venus")
+
+	_, err := NewServer(mars, mars)
+	require.EqualError(t, err, `multiple faucets for chain "mars"`)
+
+	s, err := NewServer(mars, venus)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	info, err := NewClient(srv.URL).FaucetInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, FaucetInfoResponse{IsAFaucet: true, Chains: []string{"mars", "venus"}}, info)
+
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	venusURL, ok := info.ChainURL(srvURL, "venus")
+	require.True(t, ok)
+	require.Equal(t, srv.URL+"/chains/venus", venusURL.String())
+
+	_, ok = info.ChainURL(srvURL, "earth")
+	require.False(t, ok)
+
+	// requests are routed to the faucet of the chain.
+	venusInfo, err := NewClient(venusURL.String()).FaucetInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "venus", venusInfo.ChainID)
+
+	res, err := NewClient(venusURL.String()).Transfer(ctx, NewTransferRequest(addrA, []string{"1token"}))
+	require.NoError(t, err)
+	require.Empty(t, res.Error)
+	require.Len(t, venusBroadcaster.msgs, 1)
+	require.Empty(t, marsBroadcaster.msgs)
+
+	_, err = NewClient(srv.URL+"/chains/earth").Transfer(ctx, NewTransferRequest(addrA, nil))
+	require.Error(t, err)
+
+	// the faucet of the chain is used when retrieving tokens from the server.
+	require.NoError(t, TryRetrieve(ctx, "mars", "", srv.URL, addrB))
+	require.Len(t, marsBroadcaster.msgs, 1)
+}
+
+func TestServerMetricsBalanceRefreshedOnScrape(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		marsBroadcaster  = &balanceBroadcasterMock{balance: 100}
+		venusBroadcaster = &balanceBroadcasterMock{balance: 50}
+		mars             = newTestFaucet(ctx, marsBroadcaster)
+		venus            = newTestFaucet(ctx, venusBroadcaster)
+	)
+	mars.chainID, mars.metrics = "mars", newMetrics("mars")
+	venus.chainID, venus.metrics = "venus", newMetrics("venus")
+
+	s, err := NewServer(mars, venus)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	scrape := func() string {
+		res, err := http.Get(srv.URL + "/metrics")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		metrics, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(metrics)
+	}
+
+	metrics := scrape()
+	require.Contains(t, metrics, `faucet_account_balance{chain_id="mars",denom="token"} 100`)
+	require.Contains(t, metrics, `faucet_account_balance{chain_id="venus",denom="token"} 50`)
+
+	// the accounts are drained from outside while the faucets are idle.
+	marsBroadcaster.setBalance(40)
+	venusBroadcaster.setBalance(10)
+
+	metrics = scrape()
+	require.Contains(t, metrics, `faucet_account_balance{chain_id="mars",denom="token"} 40`)
+	require.Contains(t, metrics, `faucet_account_balance{chain_id="venus",denom="token"} 10`)
+}
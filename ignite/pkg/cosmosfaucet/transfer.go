@@ -2,100 +2,282 @@ package cosmosfaucet
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
-	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	// ErrFaucetStopped is returned when a transfer is requested after the faucet is stopped.
+	ErrFaucetStopped = errors.New("faucet is stopped")
 
-	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	// ErrInvalidAddress is returned when a transfer is requested for an address that is not
+	// an account address of the chain.
+	ErrInvalidAddress = errors.New("invalid account address")
 )
 
-// transferMutex is a mutex used for keeping transfer requests in a queue so checking account balance and sending tokens is atomic
-var transferMutex = &sync.Mutex{}
+// TxBroadcaster broadcasts txs to the chain.
+type TxBroadcaster interface {
+	// BroadcastTx broadcasts a tx with msgs signed by the account and returns
+	// once the tx is included in a block.
+	BroadcastTx(ctx context.Context, accountName string, msgs ...sdk.Msg) error
+}
+
+// transferRequest is a transfer waiting to be sent with the next batch.
+type transferRequest struct {
+	ctx              context.Context
+	toAccountAddress string
+	coins            sdk.Coins
+
+	// result receives the result of the batch that the transfer is sent with.
+	result chan error
+}
 
-// TotalTransferredAmount returns the total transferred amount from faucet account to toAccountAddress.
-func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, denom string) (totalAmount uint64, err error) {
-	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
+// TotalTransferredAmount returns the total transferred amount from faucet account to toAccountAddress
+// within the refresh window.
+func (f Faucet) TotalTransferredAmount(_ context.Context, toAccountAddress, denom string) (totalAmount uint64, err error) {
+	usage, err := f.limitStore.Usage(toAccountAddress)
 	if err != nil {
 		return 0, err
 	}
 
-	events, err := f.runner.QueryTxEvents(ctx,
-		chaincmdrunner.NewEventSelector("message", "sender", fromAccount.Address),
-		chaincmdrunner.NewEventSelector("transfer", "recipient", toAccountAddress))
+	return usage.Total(denom, f.limitWindowStart()), nil
+}
+
+// Usage returns the transfers sent to address within the refresh window.
+func (f Faucet) Usage(address string) (Usage, error) {
+	usage, err := f.limitStore.Usage(address)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	for _, event := range events {
-		if event.Type == "transfer" {
-			for _, attr := range event.Attributes {
-				if attr.Key == "amount" {
-					coins, err := sdk.ParseCoinsNormalized(attr.Value)
-					if err != nil {
-						return 0, err
-					}
+	usage.add(nil, time.Now(), f.limitWindowStart())
 
-					amount := coins.AmountOf(denom).Uint64()
+	return usage, nil
+}
 
-					if amount > 0 && time.Since(event.Time) < f.limitRefreshWindow {
-						totalAmount += amount
-					}
-				}
-			}
-		}
+// ResetUsage forgets the transfers sent to address so the max amounts
+// can be sent to it again.
+func (f Faucet) ResetUsage(address string) error {
+	return f.limitStore.ResetUsage(address)
+}
+
+// recordTransfer records the coins sent to address to enforce the max amounts.
+func (f Faucet) recordTransfer(address string, coins sdk.Coins, t time.Time) error {
+	usage, err := f.limitStore.Usage(address)
+	if err != nil {
+		return err
 	}
 
-	return totalAmount, nil
+	usage.add(coins, t, f.limitWindowStart())
+
+	return f.limitStore.SetUsage(address, usage)
+}
+
+// limitWindowStart returns the start of the refresh window, transfers sent
+// before it don't count for the max amounts anymore.
+func (f Faucet) limitWindowStart() time.Time {
+	return time.Now().Add(-f.limitRefreshWindow)
 }
 
 // Transfer transfer amount of tokens from the faucet account to toAccountAddress.
-func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
-	transferMutex.Lock()
-	defer transferMutex.Unlock()
+// transfers requested at the same time are batched and sent with a single tx.
+func (f Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
+	// an invalid address would fail the tx of the whole batch.
+	if _, err := sdk.GetFromBech32(toAccountAddress, f.accountPrefix); err != nil {
+		return fmt.Errorf("%w %q: %v", ErrInvalidAddress, toAccountAddress, err)
+	}
 
-	var coinsStr []string
+	coins = append(sdk.Coins{}, coins...).Sort()
+	if err := coins.Validate(); err != nil {
+		return err
+	}
 
-	// check for each coin, the max transferred amount hasn't been reached
 	for _, c := range coins {
-		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
-		if err != nil {
-			return err
+		if !f.coins.AmountOf(c.Denom).IsPositive() {
+			return fmt.Errorf("%q denom is not distributed by the faucet", c.Denom)
 		}
+	}
 
-		if f.coinsMax[c.Denom] != 0 {
-			if totalSent >= f.coinsMax[c.Denom] {
-				return fmt.Errorf(
-					"account has reached to the max. allowed amount (%d) for %q denom",
-					f.coinsMax[c.Denom],
-					c.Denom,
-				)
-			}
+	f.metrics.queueDepth.Inc()
+	defer f.metrics.queueDepth.Dec()
+
+	req := transferRequest{
+		ctx:              ctx,
+		toAccountAddress: toAccountAddress,
+		coins:            coins,
+		result:           make(chan error, 1),
+	}
+
+	select {
+	case f.transfers <- req:
+	case <-f.stopped:
+		return ErrFaucetStopped
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-req.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// processTransfers collects transfer requests into batches and sends them
+// until ctx is canceled.
+func (f Faucet) processTransfers(ctx context.Context, stopped chan struct{}) {
+	defer close(stopped)
+
+	f.updateBalance(ctx)
+
+	for {
+		var batch []transferRequest
+
+		// wait for the first request of the batch.
+		select {
+		case req := <-f.transfers:
+			batch = append(batch, req)
+		case <-ctx.Done():
+			return
+		}
+
+		// collect the requests that arrive during the batch window.
+		timer := time.NewTimer(f.batchWindow)
 
-			if (totalSent + c.Amount.Uint64()) > f.coinsMax[c.Denom] {
-				return fmt.Errorf(
-					`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
-					c.Denom,
-					f.coinsMax[c.Denom],
-				)
+	collect:
+		for len(batch) < f.maxBatchSize {
+			select {
+			case req := <-f.transfers:
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			case <-ctx.Done():
+				timer.Stop()
+				for _, req := range batch {
+					req.result <- ErrFaucetStopped
+				}
+				return
 			}
 		}
 
-		coinsStr = append(coinsStr, c.String())
+		timer.Stop()
+
+		f.sendBatch(ctx, batch)
 	}
+}
 
-	// perform transfer for all coins
-	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
-	if err != nil {
-		return err
+// sendBatch sends the transfers that don't exceed the max amounts in a single
+// multi send tx and passes the result to each of them.
+func (f Faucet) sendBatch(ctx context.Context, batch []transferRequest) {
+	var (
+		accepted []transferRequest
+		outputs  []banktypes.Output
+		total    sdk.Coins
+
+		// pending holds the coins accepted for each recipient in this batch
+		// since they're not yet included in the transferred amounts.
+		pending = make(map[string]sdk.Coins)
+	)
+
+	for _, req := range batch {
+		// the requester isn't waiting for the transfer anymore.
+		if req.ctx.Err() != nil {
+			continue
+		}
+
+		if err := f.checkMaxAmounts(ctx, req.toAccountAddress, req.coins, pending[req.toAccountAddress]); err != nil {
+			req.result <- err
+			continue
+		}
+
+		pending[req.toAccountAddress] = pending[req.toAccountAddress].Add(req.coins...)
+		total = total.Add(req.coins...)
+		outputs = append(outputs, banktypes.Output{
+			Address: req.toAccountAddress,
+			Coins:   req.coins,
+		})
+		accepted = append(accepted, req)
 	}
-	txHash, err := f.runner.BankSend(ctx, fromAccount.Address, toAccountAddress, strings.Join(coinsStr, ","))
+
+	if len(accepted) == 0 {
+		return
+	}
+
+	msg := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{
+			{
+				Address: f.accountAddress,
+				Coins:   total,
+			},
+		},
+		Outputs: outputs,
+	}
+
+	start := time.Now()
+	err := f.broadcaster.BroadcastTx(ctx, f.accountName, msg)
+	f.metrics.txDuration.Observe(time.Since(start).Seconds())
+
 	if err != nil {
-		return err
+		for _, req := range accepted {
+			req.result <- err
+		}
+		return
+	}
+
+	for _, c := range total {
+		f.metrics.distributed.WithLabelValues(c.Denom).Add(float64(c.Amount.Uint64()))
+	}
+
+	f.updateBalance(ctx)
+
+	now := time.Now()
+
+	for _, req := range accepted {
+		if err := f.recordTransfer(req.toAccountAddress, req.coins, now); err != nil {
+			req.result <- fmt.Errorf("coins are sent but cannot be recorded for the max amounts: %w", err)
+			continue
+		}
+
+		req.result <- nil
+	}
+}
+
+// checkMaxAmounts checks for each coin, the max transferred amount hasn't been reached
+// by including the pending coins that are about to be sent to toAccountAddress.
+func (f Faucet) checkMaxAmounts(ctx context.Context, toAccountAddress string, coins, pending sdk.Coins) error {
+	for _, c := range coins {
+		if f.coinsMax[c.Denom] == 0 {
+			continue
+		}
+
+		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
+		if err != nil {
+			return err
+		}
+
+		totalSent += pending.AmountOf(c.Denom).Uint64()
+
+		if totalSent >= f.coinsMax[c.Denom] {
+			return fmt.Errorf(
+				"account has reached to the max. allowed amount (%d) for %q denom",
+				f.coinsMax[c.Denom],
+				c.Denom,
+			)
+		}
+
+		if (totalSent + c.Amount.Uint64()) > f.coinsMax[c.Denom] {
+			return fmt.Errorf(
+				`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
+				c.Denom,
+				f.coinsMax[c.Denom],
+			)
+		}
 	}
 
-	// wait for the send tx to be confirmed
-	return f.runner.WaitTx(ctx, txHash, time.Second, 30)
+	return nil
 }
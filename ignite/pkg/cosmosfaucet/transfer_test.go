@@ -0,0 +1,200 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+// addresses of test accounts with the prefix of the test faucet.
+var (
+	addrA      = testAddress("a")
+	addrB      = testAddress("b")
+	addrC      = testAddress("c")
+	addrDenied = testAddress("denied")
+)
+
+// testAddress returns the address of an account with the cosmos prefix made from name.
+func testAddress(name string) string {
+	return sdk.MustBech32ifyAddressBytes("cosmos", []byte(fmt.Sprintf("%-20s", name)))
+}
+
+type broadcasterMock struct {
+	mu   sync.Mutex
+	msgs []sdk.Msg
+	err  error
+}
+
+func (b *broadcasterMock) BroadcastTx(_ context.Context, _ string, msgs ...sdk.Msg) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.msgs = append(b.msgs, msgs...)
+	return b.err
+}
+
+func newTestFaucet(ctx context.Context, b TxBroadcaster) Faucet {
+	f := Faucet{
+		accountName:        "faucet",
+		accountAddress:     "cosmos1faucet",
+		accountPrefix:      "cosmos",
+		coins:              sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 5)),
+		coinsMax:           map[string]uint64{"token": 10},
+		broadcaster:        b,
+		limitStore:         NewMemoryLimitStore(),
+		limitRefreshWindow: time.Hour,
+		batchWindow:        100 * time.Millisecond,
+		maxBatchSize:       DefaultMaxBatchSize,
+		metrics:            newMetrics(""),
+	}
+	f.start(ctx)
+	return f
+}
+
+func TestTransferBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		b = &broadcasterMock{}
+		f = newTestFaucet(ctx, b)
+		g errgroup.Group
+	)
+
+	for _, addr := range []string{addrA, addrB, addrC} {
+		addr := addr
+		g.Go(func() error {
+			return f.Transfer(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("token", 5)))
+		})
+	}
+	require.NoError(t, g.Wait())
+
+	require.Len(t, b.msgs, 1)
+	msg, ok := b.msgs[0].(*banktypes.MsgMultiSend)
+	require.True(t, ok)
+	require.Equal(t, []banktypes.Input{
+		{Address: "cosmos1faucet", Coins: sdk.NewCoins(sdk.NewInt64Coin("token", 15))},
+	}, msg.Inputs)
+
+	var recipients []string
+	for _, o := range msg.Outputs {
+		recipients = append(recipients, o.Address)
+	}
+	require.ElementsMatch(t, []string{addrA, addrB, addrC}, recipients)
+}
+
+func TestTransferInvalidAddress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		b     = &broadcasterMock{}
+		f     = newTestFaucet(ctx, b)
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 5))
+		g     errgroup.Group
+	)
+
+	for _, addr := range []string{
+		"cosmos1a",
+		sdk.MustBech32ifyAddressBytes("osmo", []byte(fmt.Sprintf("%-20s", "a"))),
+		"",
+	} {
+		require.ErrorIs(t, f.Transfer(ctx, addr, coins), ErrInvalidAddress)
+	}
+
+	// an invalid address doesn't fail the transfers of the batch it's requested with.
+	g.Go(func() error { return f.Transfer(ctx, addrA, coins) })
+	g.Go(func() error { return f.Transfer(ctx, addrB, coins) })
+	require.ErrorIs(t, f.Transfer(ctx, "cosmos1invalid", coins), ErrInvalidAddress)
+	require.NoError(t, g.Wait())
+	require.Len(t, b.msgs, 1)
+
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).Transfer(ctx, NewTransferRequest("cosmos1invalid", nil))
+	require.Equal(t, ErrTransferRequest{http.StatusBadRequest}, err)
+}
+
+func TestTransferBatchError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		errBroadcast = errors.New("insufficient funds")
+		f            = newTestFaucet(ctx, &broadcasterMock{err: errBroadcast})
+	)
+
+	err := f.Transfer(ctx, addrA, sdk.NewCoins(sdk.NewInt64Coin("token", 5)))
+	require.ErrorIs(t, err, errBroadcast)
+
+	err = f.Transfer(ctx, addrA, sdk.NewCoins(sdk.NewInt64Coin("nonexistent", 5)))
+	require.EqualError(t, err, `"nonexistent" denom is not distributed by the faucet`)
+
+	cancel()
+	<-f.stopped
+
+	err = f.Transfer(context.Background(), addrA, sdk.NewCoins(sdk.NewInt64Coin("token", 5)))
+	require.ErrorIs(t, err, ErrFaucetStopped)
+}
+
+func TestTransferMaxAmounts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		f    = newTestFaucet(ctx, &broadcasterMock{})
+		coin = sdk.NewCoins(sdk.NewInt64Coin("token", 5))
+		g    errgroup.Group
+	)
+
+	// both transfers are sent with the same batch and reach the max amount together.
+	for i := 0; i < 2; i++ {
+		g.Go(func() error { return f.Transfer(ctx, addrA, coin) })
+	}
+	require.NoError(t, g.Wait())
+
+	total, err := f.TotalTransferredAmount(ctx, addrA, "token")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), total)
+
+	err = f.Transfer(ctx, addrA, coin)
+	require.EqualError(t, err, `account has reached to the max. allowed amount (10) for "token" denom`)
+
+	// the max amount can be sent again after the usage is reset.
+	require.NoError(t, f.ResetUsage(addrA))
+	require.NoError(t, f.Transfer(ctx, addrA, coin))
+}
+
+func TestDefaultLimitStore(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := defaultLimitStore("mars")
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	require.NoError(t, store.SetUsage(addrA, Usage{"token": {{Amount: 10, Time: now}}}))
+
+	// transfers are kept when the faucet is restarted.
+	store, err = defaultLimitStore("mars")
+	require.NoError(t, err)
+	usage, err := store.Usage(addrA)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), usage.Total("token", time.Time{}))
+
+	// transfers are kept by chain.
+	store, err = defaultLimitStore("venus")
+	require.NoError(t, err)
+	usage, err = store.Usage(addrA)
+	require.NoError(t, err)
+	require.Empty(t, usage)
+}
@@ -29,7 +29,7 @@ func TryRetrieve(
 
 	if faucetAddress != "" {
 		// use if there is a user given faucet address.
-		faucetURL, err = url.Parse(faucetAddress)
+		faucetURL, err = userFaucetURL(ctx, chainID, faucetAddress)
 	} else {
 		// find faucet url. can be the user given, otherwise it is the guessed one.
 		faucetURL, err = discoverFaucetURL(ctx, chainID, rpcAddress)
@@ -56,6 +56,27 @@ func TryRetrieve(
 	return nil
 }
 
+// userFaucetURL returns the URL of the faucet at faucetAddress for the chain.
+// faucetAddress is used as is unless it is a server for multiple chains.
+func userFaucetURL(ctx context.Context, chainID, faucetAddress string) (*url.URL, error) {
+	u, err := url.Parse(faucetAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := NewClient(u.String()).FaucetInfo(ctx)
+	if err != nil || !info.IsAFaucet {
+		return u, nil
+	}
+
+	// the faucet of a single chain is used as given.
+	if chainURL, ok := info.ChainURL(u, chainID); ok || info.ChainID != "" {
+		return chainURL, nil
+	}
+
+	return nil, fmt.Errorf("faucet at %s doesn't serve chain %q", faucetAddress, chainID)
+}
+
 func discoverFaucetURL(ctx context.Context, chainID, rpcAddress string) (*url.URL, error) {
 	// guess faucet address otherwise.
 	guessedURLs, err := guessFaucetURLs(rpcAddress)
@@ -73,13 +94,15 @@ func discoverFaucetURL(ctx context.Context, chainID, rpcAddress string) (*url.UR
 			continue
 		}
 
-		// ensure that this is a real faucet server.
+		// ensure that this is a real faucet server that serves the chain.
 		info, err := NewClient(u.String()).FaucetInfo(ctx)
-		if err != nil || info.ChainID != chainID || !info.IsAFaucet {
+		if err != nil || !info.IsAFaucet {
 			continue
 		}
 
-		return u, nil
+		if chainURL, ok := info.ChainURL(u, chainID); ok {
+			return chainURL, nil
+		}
 	}
 
 	return nil, errors.New("no faucet available, please send coins to the address")
@@ -3,6 +3,7 @@ package cosmosgen
 import (
 	"context"
 	"path/filepath"
+	"runtime"
 
 	gomodmodule "golang.org/x/mod/module"
 
@@ -25,6 +26,8 @@ type generateOptions struct {
 	dartOut               func(module.Module) string
 	dartIncludeThirdParty bool
 	dartRootPath          string
+
+	workers int
 }
 
 // TODO add WithInstall.
@@ -79,6 +82,14 @@ func WithOpenAPIGeneration(out string) Option {
 	}
 }
 
+// Workers sets the max number of modules to generate code for in parallel.
+// Defaults to the number of CPUs.
+func Workers(n int) Option {
+	return func(o *generateOptions) {
+		o.workers = n
+	}
+}
+
 // IncludeDirs configures the third party proto dirs that used by app's proto.
 // relative to the projectPath.
 func IncludeDirs(dirs []string) Option {
@@ -107,7 +118,7 @@ func Generate(ctx context.Context, cacheStorage cache.Storage, appPath, protoDir
 		ctx:          ctx,
 		appPath:      appPath,
 		protoDir:     protoDir,
-		o:            &generateOptions{},
+		o:            &generateOptions{workers: runtime.NumCPU()},
 		thirdModules: make(map[string][]module.Module),
 		cacheStorage: cacheStorage,
 	}
@@ -116,6 +127,10 @@ func Generate(ctx context.Context, cacheStorage cache.Storage, appPath, protoDir
 		apply(g.o)
 	}
 
+	if g.o.workers < 1 {
+		g.o.workers = 1
+	}
+
 	if err := g.setup(); err != nil {
 		return err
 	}
@@ -9,8 +9,8 @@ import (
 
 	"github.com/mattn/go-zglob"
 	"github.com/pkg/errors"
-	"golang.org/x/sync/errgroup"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosanalysis/module"
 	"github.com/ignite-hq/cli/ignite/pkg/protoc"
 	protocgendart "github.com/ignite-hq/cli/ignite/pkg/protoc-gen-dart"
@@ -25,6 +25,7 @@ var (
 const (
 	dartExportFileName = "export.dart"
 	dartClientDirName  = "client"
+	dartCacheNamespace = "generate.dart.dirchange"
 )
 
 type dartGenerator struct {
@@ -48,12 +49,21 @@ func (g *dartGenerator) generateModules() error {
 	}
 	defer cleanup()
 
-	gg := &errgroup.Group{}
+	var targets []cachedTarget
 
 	add := func(sourcePath string, modules []module.Module) {
 		for _, m := range modules {
 			m := m
-			gg.Go(func() error { return g.generateModule(g.g.ctx, flag, sourcePath, m) })
+			out := g.g.o.dartOut(m)
+
+			targets = append(targets, cachedTarget{
+				key:        cache.Key(m.Pkg.Path, out),
+				sourcePath: sourcePath,
+				paths:      append([]string{m.Pkg.Path, out}, g.g.o.includeDirs...),
+				generate: func() error {
+					return g.generateModule(g.g.ctx, flag, sourcePath, m)
+				},
+			})
 		}
 	}
 
@@ -65,7 +75,7 @@ func (g *dartGenerator) generateModules() error {
 		}
 	}
 
-	return gg.Wait()
+	return g.g.generateCachedTargets(dartCacheNamespace, targets)
 }
 
 func (g *dartGenerator) generateModule(ctx context.Context, plugin, appPath string, m module.Module) error {
@@ -3,14 +3,18 @@ package cosmosgen
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/protoanalysis"
 	"github.com/ignite-hq/cli/ignite/pkg/protoc"
 )
 
+const goCacheNamespace = "generate.go.dirchange"
+
 var (
 	goOuts = []string{
 		"--gocosmos_out=plugins=interfacetype+grpc,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:.",
@@ -40,11 +44,36 @@ func (g *generator) generateGo() error {
 		return err
 	}
 
-	// code generate for each module.
+	// code generate for each module that has been changed.
+	var targets []cachedTarget
 	for _, pkg := range pkgs {
-		if err := protoc.Generate(g.ctx, tmp, pkg.Path, includePaths, goOuts); err != nil {
-			return err
-		}
+		pkg := pkg
+
+		// generated code is placed in the dir of the go_package inside the app.
+		out := filepath.Join(g.appPath, strings.TrimPrefix(pkg.GoImportPath(), g.o.gomodPath))
+
+		targets = append(targets, cachedTarget{
+			key:        cache.Key(pkg.Path, out),
+			sourcePath: g.appPath,
+			paths:      append([]string{pkg.Path, out}, g.o.includeDirs...),
+			generate: func() error {
+				return protoc.Generate(g.ctx, tmp, pkg.Path, includePaths, goOuts)
+			},
+		})
+	}
+
+	checksumCache := cache.New[[]byte](g.cacheStorage, goCacheNamespace)
+
+	changed, err := changedTargets(checksumCache, targets)
+	if err != nil {
+		return err
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if err := g.generateTargets(changed); err != nil {
+		return err
 	}
 
 	// move generated code for the app under the relative locations in its source code.
@@ -60,5 +89,7 @@ func (g *generator) generateGo() error {
 		return err
 	}
 
-	return nil
+	// checksums are saved after the generated code is moved because
+	// they include the generated code.
+	return saveChecksums(checksumCache, changed)
 }
@@ -8,11 +8,9 @@ import (
 	"strings"
 
 	"github.com/iancoleman/strcase"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosanalysis/module"
-	"github.com/ignite-hq/cli/ignite/pkg/dirchange"
 	"github.com/ignite-hq/cli/ignite/pkg/gomodulepath"
 	"github.com/ignite-hq/cli/ignite/pkg/localfs"
 	"github.com/ignite-hq/cli/ignite/pkg/nodetime/programs/sta"
@@ -32,8 +30,9 @@ var (
 )
 
 const (
-	vuexRootMarker          = "vuex-root"
-	dirchangeCacheNamespace = "generate.javascript.dirchange"
+	vuexRootMarker     = "vuex-root"
+	jsCacheNamespace   = "generate.javascript.dirchange"
+	vuexCacheNamespace = "generate.vuex.dirchange"
 )
 
 type jsGenerator struct {
@@ -63,29 +62,31 @@ func (g *jsGenerator) generateModules() error {
 	}
 	defer cleanup()
 
-	gg := &errgroup.Group{}
+	cacheNamespace := jsCacheNamespace
+	if g.g.o.vuexStoreRootPath != "" {
+		cacheNamespace = vuexCacheNamespace
+	}
+
+	var targets []cachedTarget
 
-	dirCache := cache.New[[]byte](g.g.cacheStorage, dirchangeCacheNamespace)
 	add := func(sourcePath string, modules []module.Module) {
 		for _, m := range modules {
 			m := m
-			gg.Go(func() error {
-				cacheKey := m.Pkg.Path
-				paths := append([]string{m.Pkg.Path, g.g.o.jsOut(m)}, g.g.o.includeDirs...)
-				changed, err := dirchange.HasDirChecksumChanged(dirCache, cacheKey, sourcePath, paths...)
-				if err != nil {
-					return err
-				}
-
-				if !changed {
-					return nil
-				}
-
-				if err := g.generateModule(g.g.ctx, tsprotoPluginPath, sourcePath, m); err != nil {
-					return err
-				}
-
-				return dirchange.SaveDirChecksum(dirCache, cacheKey, sourcePath, paths...)
+			out := g.g.o.jsOut(m)
+
+			paths := append([]string{m.Pkg.Path, out}, g.g.o.includeDirs...)
+			if g.g.o.vuexStoreRootPath != "" {
+				// Vuex stores are generated next to the JS code.
+				paths = append(paths, filepath.Dir(out))
+			}
+
+			targets = append(targets, cachedTarget{
+				key:        cache.Key(m.Pkg.Path, out),
+				sourcePath: sourcePath,
+				paths:      paths,
+				generate: func() error {
+					return g.generateModule(g.g.ctx, tsprotoPluginPath, sourcePath, m)
+				},
 			})
 		}
 	}
@@ -98,7 +99,7 @@ func (g *jsGenerator) generateModules() error {
 		}
 	}
 
-	return gg.Wait()
+	return g.g.generateCachedTargets(cacheNamespace, targets)
 }
 
 // generateModule generates generates JS code for a module.
@@ -0,0 +1,97 @@
+package cosmosgen
+
+import (
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/dirchange"
+)
+
+// cachedTarget is a unit of code generation, usually a module, that is skipped
+// when its proto files, include dirs and generated code didn't change since
+// the last time code is generated for it.
+type cachedTarget struct {
+	// key identifies the target inside its cache namespace.
+	key string
+
+	// sourcePath is the dir that paths are relative to.
+	sourcePath string
+
+	// paths are checksummed to detect changes on the target.
+	paths []string
+
+	// generate generates code for the target.
+	generate func() error
+}
+
+// changedTargets returns the targets that have changed since their checksums
+// were saved to checksumCache.
+func changedTargets(checksumCache cache.Cache[[]byte], targets []cachedTarget) (changed []cachedTarget, err error) {
+	for _, t := range targets {
+		hasChanged, err := dirchange.HasDirChecksumChanged(checksumCache, t.key, t.sourcePath, t.paths...)
+		if err != nil {
+			return nil, err
+		}
+
+		if hasChanged {
+			changed = append(changed, t)
+		}
+	}
+
+	return changed, nil
+}
+
+// saveChecksums saves checksums of targets to checksumCache so they're skipped
+// by the next generation when they don't change.
+func saveChecksums(checksumCache cache.Cache[[]byte], targets []cachedTarget) error {
+	for _, t := range targets {
+		if err := dirchange.SaveDirChecksum(checksumCache, t.key, t.sourcePath, t.paths...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateTargets generates code for targets in parallel by running at most
+// the configured number of workers at the same time.
+func (g *generator) generateTargets(targets []cachedTarget) error {
+	var (
+		gg      = &errgroup.Group{}
+		workers = make(chan struct{}, g.o.workers)
+	)
+
+	for _, t := range targets {
+		t := t
+
+		gg.Go(func() error {
+			select {
+			case workers <- struct{}{}:
+			case <-g.ctx.Done():
+				return g.ctx.Err()
+			}
+			defer func() { <-workers }()
+
+			return t.generate()
+		})
+	}
+
+	return gg.Wait()
+}
+
+// generateCachedTargets generates code only for the changed targets and
+// saves their checksums once all of them are generated.
+func (g *generator) generateCachedTargets(cacheNamespace string, targets []cachedTarget) error {
+	checksumCache := cache.New[[]byte](g.cacheStorage, cacheNamespace)
+
+	changed, err := changedTargets(checksumCache, targets)
+	if err != nil {
+		return err
+	}
+
+	if err := g.generateTargets(changed); err != nil {
+		return err
+	}
+
+	return saveChecksums(checksumCache, changed)
+}
@@ -0,0 +1,67 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+)
+
+func TestGenerateCachedTargets(t *testing.T) {
+	appPath := t.TempDir()
+
+	cacheStorage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	g := &generator{
+		ctx:          context.Background(),
+		cacheStorage: cacheStorage,
+		appPath:      appPath,
+		o:            &generateOptions{workers: 1},
+	}
+
+	var (
+		m         sync.Mutex
+		generated []string
+	)
+
+	targets := make([]cachedTarget, 0, 2)
+	for _, name := range []string{"mars", "venus"} {
+		name := name
+		path := filepath.Join(appPath, name)
+
+		require.NoError(t, os.MkdirAll(path, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "a.proto"), []byte(name), 0644))
+
+		targets = append(targets, cachedTarget{
+			key:        name,
+			sourcePath: appPath,
+			paths:      []string{name},
+			generate: func() error {
+				m.Lock()
+				defer m.Unlock()
+				generated = append(generated, name)
+				return nil
+			},
+		})
+	}
+
+	// all targets are generated the first time.
+	require.NoError(t, g.generateCachedTargets("test", targets))
+	require.ElementsMatch(t, []string{"mars", "venus"}, generated)
+
+	// nothing changed so nothing is generated.
+	generated = nil
+	require.NoError(t, g.generateCachedTargets("test", targets))
+	require.Empty(t, generated)
+
+	// only the changed target is generated.
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "venus", "a.proto"), []byte("changed"), 0644))
+	require.NoError(t, g.generateCachedTargets("test", targets))
+	require.Equal(t, []string{"venus"}, generated)
+}
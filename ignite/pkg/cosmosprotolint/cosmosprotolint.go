@@ -0,0 +1,323 @@
+// Package cosmosprotolint checks proto files of Cosmos SDK apps for the conventions
+// used by the SDK and its clients.
+package cosmosprotolint
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite-hq/cli/ignite/pkg/protoanalysis"
+)
+
+const (
+	// RuleMsgName checks that Msg service RPCs use defined Msg prefixed request types named after them.
+	RuleMsgName = "msg-name"
+
+	// RuleMsgResponse checks that every Msg has a response type.
+	RuleMsgResponse = "msg-response"
+
+	// RuleMsgSigner checks that every Msg has a field for the signer address.
+	RuleMsgSigner = "msg-signer"
+
+	// RuleQueryName checks that Query service RPCs use request and response types named after them.
+	RuleQueryName = "query-name"
+
+	// RuleGoPackage checks that the go_package option points to a package of the app's Go module.
+	RuleGoPackage = "go-package"
+
+	// RuleImportCycle checks that proto packages don't import each other in cycles.
+	RuleImportCycle = "import-cycle"
+)
+
+const (
+	serviceMsg   = "Msg"
+	serviceQuery = "Query"
+)
+
+// conventionalSignerFields are the field names used for signers by Msgs that don't
+// set the cosmos.msg.v1.signer option, like the ones scaffolded by Ignite CLI.
+var conventionalSignerFields = []string{"creator", "signer", "sender", "authority", "from_address"}
+
+// Issue is a convention violation found in the proto files.
+type Issue struct {
+	// Rule is the name of the violated rule.
+	Rule string `json:"rule"`
+
+	// Path of the proto file or package where the issue is found.
+	Path string `json:"path"`
+
+	// Message describes the issue.
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s (%s)", i.Path, i.Message, i.Rule)
+}
+
+// Lint checks the proto files of the app at appPath found under its protoDir.
+func Lint(ctx context.Context, appPath, protoDir string) ([]Issue, error) {
+	modulePath, err := gomodulepath.ParseAt(appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	protoPath := filepath.Join(appPath, protoDir)
+
+	pkgs, err := protoanalysis.Parse(ctx, nil, protoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return LintPackages(pkgs, modulePath.RawPath, protoPath), nil
+}
+
+// LintPackages checks proto packages parsed from protoPath.
+// goModulePath is the path of the Go module that the generated code lives in.
+func LintPackages(pkgs protoanalysis.Packages, goModulePath, protoPath string) (issues []Issue) {
+	for _, pkg := range pkgs {
+		issues = append(issues, lintGoPackage(pkg, goModulePath)...)
+
+		for _, s := range pkg.Services {
+			switch s.Name {
+			case serviceMsg:
+				issues = append(issues, lintMsgService(pkgs, pkg, s)...)
+			case serviceQuery:
+				issues = append(issues, lintQueryService(pkg, s)...)
+			}
+		}
+	}
+
+	return append(issues, lintImportCycles(pkgs, protoPath)...)
+}
+
+func lintGoPackage(pkg protoanalysis.Package, goModulePath string) []Issue {
+	goImportPath := pkg.GoImportPath()
+
+	switch {
+	case goImportPath == "":
+		return []Issue{{
+			Rule:    RuleGoPackage,
+			Path:    pkg.Path,
+			Message: fmt.Sprintf("package %s has no go_package option", pkg.Name),
+		}}
+	case goImportPath != goModulePath && !strings.HasPrefix(goImportPath, goModulePath+"/"):
+		return []Issue{{
+			Rule:    RuleGoPackage,
+			Path:    pkg.Path,
+			Message: fmt.Sprintf("go_package %q of package %s is not inside Go module %s", goImportPath, pkg.Name, goModulePath),
+		}}
+	}
+
+	return nil
+}
+
+func lintMsgService(pkgs protoanalysis.Packages, pkg protoanalysis.Package, s protoanalysis.Service) (issues []Issue) {
+	for _, rpc := range s.RPCFuncs {
+		msgPkg, msg, ok := messageByType(pkgs, pkg, rpc.RequestType)
+		if !ok {
+			issues = append(issues, Issue{
+				Rule:    RuleMsgName,
+				Path:    pkg.Path,
+				Message: fmt.Sprintf("request type %s of rpc %s.%s/%s is not defined", rpc.RequestType, pkg.Name, s.Name, rpc.Name),
+			})
+			continue
+		}
+
+		if want := serviceMsg + rpc.Name; msg.Name != want {
+			issues = append(issues, Issue{
+				Rule:    RuleMsgName,
+				Path:    msg.Path,
+				Message: fmt.Sprintf("rpc %s.%s/%s should use %s as request type instead of %s", pkg.Name, s.Name, rpc.Name, want, rpc.RequestType),
+			})
+		}
+
+		wantResponse := msg.Name + "Response"
+		responsePkg, response, ok := messageByType(pkgs, pkg, rpc.ReturnsType)
+		if !ok || response.Name != wantResponse || responsePkg.Name != msgPkg.Name {
+			issues = append(issues, Issue{
+				Rule:    RuleMsgResponse,
+				Path:    msg.Path,
+				Message: fmt.Sprintf("%s should have a %s response type defined in package %s", msg.Name, wantResponse, msgPkg.Name),
+			})
+		}
+
+		issues = append(issues, lintMsgSigners(msgPkg, msg)...)
+	}
+
+	return issues
+}
+
+// messageByType returns the message with the type name used in pkg and the package that defines it.
+// The name is either local to pkg or qualified with the name of one of pkgs.
+func messageByType(pkgs protoanalysis.Packages, pkg protoanalysis.Package, typeName string) (
+	protoanalysis.Package, protoanalysis.Message, bool) {
+	name := strings.TrimPrefix(typeName, ".")
+
+	if !strings.Contains(name, ".") {
+		msg, err := pkg.MessageByName(name)
+		return pkg, msg, err == nil
+	}
+
+	for _, p := range pkgs {
+		if !strings.HasPrefix(name, p.Name+".") {
+			continue
+		}
+		if msg, err := p.MessageByName(strings.TrimPrefix(name, p.Name+".")); err == nil {
+			return p, msg, true
+		}
+	}
+
+	return protoanalysis.Package{}, protoanalysis.Message{}, false
+}
+
+func lintMsgSigners(pkg protoanalysis.Package, msg protoanalysis.Message) (issues []Issue) {
+	hasField := func(name string) bool {
+		for _, f := range msg.Fields {
+			if f.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(msg.Signers) == 0 {
+		for _, name := range conventionalSignerFields {
+			if hasField(name) {
+				return nil
+			}
+		}
+
+		return []Issue{{
+			Rule:    RuleMsgSigner,
+			Path:    msg.Path,
+			Message: fmt.Sprintf("%s.%s has no signer field, set the cosmos.msg.v1.signer option or add a creator field", pkg.Name, msg.Name),
+		}}
+	}
+
+	for _, signer := range msg.Signers {
+		if !hasField(signer) {
+			issues = append(issues, Issue{
+				Rule:    RuleMsgSigner,
+				Path:    msg.Path,
+				Message: fmt.Sprintf("signer field %s of %s.%s does not exist", signer, pkg.Name, msg.Name),
+			})
+		}
+	}
+
+	return issues
+}
+
+func lintQueryService(pkg protoanalysis.Package, s protoanalysis.Service) (issues []Issue) {
+	for _, rpc := range s.RPCFuncs {
+		var (
+			wantRequest  = fmt.Sprintf("%s%sRequest", serviceQuery, rpc.Name)
+			wantResponse = fmt.Sprintf("%s%sResponse", serviceQuery, rpc.Name)
+		)
+
+		if rpc.RequestType == wantRequest && rpc.ReturnsType == wantResponse {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Rule: RuleQueryName,
+			Path: pkg.Path,
+			Message: fmt.Sprintf(
+				"rpc %s.%s/%s should use %s and %s as request and response types instead of %s and %s",
+				pkg.Name, s.Name, rpc.Name, wantRequest, wantResponse, rpc.RequestType, rpc.ReturnsType,
+			),
+		})
+	}
+
+	return issues
+}
+
+// lintImportCycles finds cycles in the graph of proto package imports.
+func lintImportCycles(pkgs protoanalysis.Packages, protoPath string) (issues []Issue) {
+	// map proto files to their packages by their import path.
+	filePkgs := make(map[string]string)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			path, err := filepath.Rel(protoPath, f.Path)
+			if err != nil {
+				continue
+			}
+			filePkgs[filepath.ToSlash(path)] = pkg.Name
+		}
+	}
+
+	var (
+		names   []string
+		paths   = make(map[string]string)
+		imports = make(map[string][]string)
+	)
+	for _, pkg := range pkgs {
+		names = append(names, pkg.Name)
+		paths[pkg.Name] = pkg.Path
+
+		deps := make(map[string]bool)
+		for _, f := range pkg.Files {
+			for _, dep := range f.Dependencies {
+				if name, ok := filePkgs[dep]; ok && name != pkg.Name && !deps[name] {
+					deps[name] = true
+					imports[pkg.Name] = append(imports[pkg.Name], name)
+				}
+			}
+		}
+		sort.Strings(imports[pkg.Name])
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		state = make(map[string]int)
+		stack []string
+		visit func(name string)
+	)
+
+	visit = func(name string) {
+		state[name] = visiting
+		stack = append(stack, name)
+
+		for _, dep := range imports[name] {
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				// the cycle is the part of the stack starting with dep.
+				var cycle []string
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == dep {
+						cycle = append(cycle, stack[i:]...)
+						break
+					}
+				}
+
+				issues = append(issues, Issue{
+					Rule:    RuleImportCycle,
+					Path:    paths[dep],
+					Message: fmt.Sprintf("import cycle: %s -> %s", strings.Join(cycle, " -> "), dep),
+				})
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+	}
+
+	for _, name := range names {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,121 @@
+package cosmosprotolint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosprotolint"
+	"github.com/ignite-hq/cli/ignite/pkg/protoanalysis"
+)
+
+func TestLintPackages(t *testing.T) {
+	pkgs := protoanalysis.Packages{
+		{
+			Name:         "mars.mars",
+			Path:         "proto/mars",
+			GoImportName: "github.com/username/mars/x/mars/types",
+			Files: protoanalysis.Files{
+				{Path: "proto/mars/tx.proto", Dependencies: []string{"venus/venus.proto"}},
+			},
+			Messages: []protoanalysis.Message{
+				{
+					Name:   "MsgCreatePost",
+					Path:   "proto/mars/tx.proto",
+					Fields: []protoanalysis.Field{{Name: "creator", Number: 1, Type: "string"}},
+				},
+				{Name: "MsgCreatePostResponse", Path: "proto/mars/tx.proto"},
+				{
+					Name:    "MsgDeletePost",
+					Path:    "proto/mars/tx.proto",
+					Fields:  []protoanalysis.Field{{Name: "id", Number: 1, Type: "uint64"}},
+					Signers: []string{"owner"},
+				},
+				{
+					Name:   "MsgLike",
+					Path:   "proto/mars/tx.proto",
+					Fields: []protoanalysis.Field{{Name: "id", Number: 1, Type: "uint64"}},
+				},
+				{Name: "MsgLikeResponse", Path: "proto/mars/tx.proto"},
+				{
+					Name:   "MsgVotePost",
+					Path:   "proto/mars/tx.proto",
+					Fields: []protoanalysis.Field{{Name: "creator", Number: 1, Type: "string"}},
+				},
+				{Name: "MsgVotePostResponse", Path: "proto/mars/tx.proto"},
+			},
+			Services: []protoanalysis.Service{
+				{
+					Name: "Msg",
+					RPCFuncs: []protoanalysis.RPCFunc{
+						{Name: "CreatePost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+						{Name: "DeletePost", RequestType: "MsgDeletePost", ReturnsType: "MsgDeletePostResponse"},
+						{Name: "LikePost", RequestType: "MsgLike", ReturnsType: "MsgLikeResponse"},
+						{Name: "VotePost", RequestType: ".mars.mars.MsgVotePost", ReturnsType: "mars.mars.MsgVotePostResponse"},
+						{Name: "SharePost", RequestType: "MsgSharePost", ReturnsType: "MsgSharePostResponse"},
+					},
+				},
+				{
+					Name: "Query",
+					RPCFuncs: []protoanalysis.RPCFunc{
+						{Name: "Post", RequestType: "QueryPostRequest", ReturnsType: "QueryPostResponse"},
+						{Name: "Posts", RequestType: "QueryPostsRequest", ReturnsType: "PostsResponse"},
+					},
+				},
+			},
+		},
+		{
+			Name:         "mars.venus",
+			Path:         "proto/venus",
+			GoImportName: "github.com/username/venus/x/venus/types",
+			Files: protoanalysis.Files{
+				{Path: "proto/venus/venus.proto", Dependencies: []string{"mars/tx.proto"}},
+			},
+		},
+	}
+
+	issues := cosmosprotolint.LintPackages(pkgs, "github.com/username/mars", "proto")
+
+	require.Equal(t, []cosmosprotolint.Issue{
+		{
+			Rule:    cosmosprotolint.RuleMsgResponse,
+			Path:    "proto/mars/tx.proto",
+			Message: "MsgDeletePost should have a MsgDeletePostResponse response type defined in package mars.mars",
+		},
+		{
+			Rule:    cosmosprotolint.RuleMsgSigner,
+			Path:    "proto/mars/tx.proto",
+			Message: "signer field owner of mars.mars.MsgDeletePost does not exist",
+		},
+		{
+			Rule:    cosmosprotolint.RuleMsgName,
+			Path:    "proto/mars/tx.proto",
+			Message: "rpc mars.mars.Msg/LikePost should use MsgLikePost as request type instead of MsgLike",
+		},
+		{
+			Rule:    cosmosprotolint.RuleMsgSigner,
+			Path:    "proto/mars/tx.proto",
+			Message: "mars.mars.MsgLike has no signer field, set the cosmos.msg.v1.signer option or add a creator field",
+		},
+		{
+			Rule:    cosmosprotolint.RuleMsgName,
+			Path:    "proto/mars",
+			Message: "request type MsgSharePost of rpc mars.mars.Msg/SharePost is not defined",
+		},
+		{
+			Rule:    cosmosprotolint.RuleQueryName,
+			Path:    "proto/mars",
+			Message: "rpc mars.mars.Query/Posts should use QueryPostsRequest and QueryPostsResponse as request and response types instead of QueryPostsRequest and PostsResponse",
+		},
+		{
+			Rule:    cosmosprotolint.RuleGoPackage,
+			Path:    "proto/venus",
+			Message: `go_package "github.com/username/venus/x/venus/types" of package mars.venus is not inside Go module github.com/username/mars`,
+		},
+		{
+			Rule:    cosmosprotolint.RuleImportCycle,
+			Path:    "proto/mars",
+			Message: "import cycle: mars.mars -> mars.venus -> mars.mars",
+		},
+	}, issues)
+}
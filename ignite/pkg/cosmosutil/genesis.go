@@ -1,7 +1,6 @@
 package cosmosutil
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -195,12 +194,11 @@ func GenesisAndHashFromURL(ctx context.Context, url string) (genesis []byte, has
 		return nil, "", err
 	}
 
-	h := sha256.New()
-	if _, err := io.Copy(h, bytes.NewReader(genesis)); err != nil {
-		return nil, "", err
-	}
-
-	hexHash := hex.EncodeToString(h.Sum(nil))
+	return genesis, GenesisHash(genesis), nil
+}
 
-	return genesis, hexHash, nil
+// GenesisHash returns the hex encoded sha256 hash of the genesis
+func GenesisHash(genesis []byte) string {
+	h := sha256.Sum256(genesis)
+	return hex.EncodeToString(h[:])
 }
@@ -0,0 +1,368 @@
+package cosmosutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+const msgCreateValidatorType = "/cosmos.staking.v1beta1.MsgCreateValidator"
+
+// DiffKind is the kind of change of a genesis entry.
+type DiffKind string
+
+const (
+	DiffAdded   DiffKind = "added"
+	DiffRemoved DiffKind = "removed"
+	DiffChanged DiffKind = "changed"
+)
+
+type (
+	// GenesisDiff is the semantic difference between two genesis.
+	GenesisDiff struct {
+		Balances        []BalanceDiff   `json:"balances"`
+		VestingAccounts []VestingDiff   `json:"vesting_accounts"`
+		Validators      []ValidatorDiff `json:"validators"`
+		Params          []ParamDiff     `json:"params"`
+	}
+
+	// BalanceDiff is the change of the balance of an account.
+	BalanceDiff struct {
+		Kind    DiffKind  `json:"kind"`
+		Address string    `json:"address"`
+		Before  sdk.Coins `json:"before,omitempty"`
+		After   sdk.Coins `json:"after,omitempty"`
+	}
+
+	// VestingDiff is the change of the vesting schedule of an account.
+	VestingDiff struct {
+		Kind    DiffKind         `json:"kind"`
+		Address string           `json:"address"`
+		Before  *VestingSchedule `json:"before,omitempty"`
+		After   *VestingSchedule `json:"after,omitempty"`
+	}
+
+	// VestingSchedule is the vesting schedule of an account, start time is zero for delayed vesting.
+	VestingSchedule struct {
+		OriginalVesting sdk.Coins `json:"original_vesting"`
+		StartTime       int64     `json:"start_time,omitempty"`
+		EndTime         int64     `json:"end_time"`
+	}
+
+	// ValidatorDiff is the change of a genesis validator and its share of the voting power in percent.
+	ValidatorDiff struct {
+		Kind           DiffKind `json:"kind"`
+		Address        string   `json:"address"`
+		Moniker        string   `json:"moniker"`
+		SelfDelegation sdk.Coin `json:"self_delegation"`
+		ShareBefore    float64  `json:"share_before"`
+		ShareAfter     float64  `json:"share_after"`
+	}
+
+	// ParamDiff is the change of a param, the key is the path of the param in the genesis.
+	ParamDiff struct {
+		Kind   DiffKind `json:"kind"`
+		Key    string   `json:"key"`
+		Before string   `json:"before,omitempty"`
+		After  string   `json:"after,omitempty"`
+	}
+)
+
+// IsEmpty checks if there is no difference between the genesis.
+func (d GenesisDiff) IsEmpty() bool {
+	return len(d.Balances) == 0 &&
+		len(d.VestingAccounts) == 0 &&
+		len(d.Validators) == 0 &&
+		len(d.Params) == 0
+}
+
+// DiffGenesis computes the semantic difference between the genesis files before and after.
+func DiffGenesis(before, after []byte) (GenesisDiff, error) {
+	b, err := parseDiffGenesis(before)
+	if err != nil {
+		return GenesisDiff{}, err
+	}
+	a, err := parseDiffGenesis(after)
+	if err != nil {
+		return GenesisDiff{}, err
+	}
+
+	var diff GenesisDiff
+
+	for _, address := range sortedKeys(b.balances, a.balances) {
+		before, hasBefore := b.balances[address]
+		after, hasAfter := a.balances[address]
+		kind, ok := diffKind(hasBefore, hasAfter, before.String() == after.String())
+		if ok {
+			diff.Balances = append(diff.Balances, BalanceDiff{
+				Kind:    kind,
+				Address: address,
+				Before:  before,
+				After:   after,
+			})
+		}
+	}
+
+	for _, address := range sortedKeys(b.vesting, a.vesting) {
+		before, hasBefore := b.vesting[address]
+		after, hasAfter := a.vesting[address]
+		equal := hasBefore && hasAfter &&
+			before.OriginalVesting.String() == after.OriginalVesting.String() &&
+			before.StartTime == after.StartTime &&
+			before.EndTime == after.EndTime
+		kind, ok := diffKind(hasBefore, hasAfter, equal)
+		if ok {
+			d := VestingDiff{Kind: kind, Address: address}
+			if hasBefore {
+				d.Before = &before
+			}
+			if hasAfter {
+				d.After = &after
+			}
+			diff.VestingAccounts = append(diff.VestingAccounts, d)
+		}
+	}
+
+	sharesBefore, sharesAfter := b.shares(), a.shares()
+	for _, address := range sortedKeys(b.validators, a.validators) {
+		before, hasBefore := b.validators[address]
+		after, hasAfter := a.validators[address]
+		equal := before.SelfDelegation.String() == after.SelfDelegation.String() &&
+			sharesBefore[address] == sharesAfter[address]
+		kind, ok := diffKind(hasBefore, hasAfter, equal)
+		if ok {
+			validator := after
+			if !hasAfter {
+				validator = before
+			}
+			diff.Validators = append(diff.Validators, ValidatorDiff{
+				Kind:           kind,
+				Address:        address,
+				Moniker:        validator.Moniker,
+				SelfDelegation: validator.SelfDelegation,
+				ShareBefore:    sharesBefore[address],
+				ShareAfter:     sharesAfter[address],
+			})
+		}
+	}
+
+	for _, key := range sortedKeys(b.params, a.params) {
+		before, hasBefore := b.params[key]
+		after, hasAfter := a.params[key]
+		kind, ok := diffKind(hasBefore, hasAfter, before == after)
+		if ok {
+			diff.Params = append(diff.Params, ParamDiff{
+				Kind:   kind,
+				Key:    key,
+				Before: before,
+				After:  after,
+			})
+		}
+	}
+
+	return diff, nil
+}
+
+// diffKind returns the kind of change of an entry, false is returned when the entry is unchanged.
+func diffKind(hasBefore, hasAfter, equal bool) (DiffKind, bool) {
+	switch {
+	case !hasBefore:
+		return DiffAdded, true
+	case !hasAfter:
+		return DiffRemoved, true
+	case !equal:
+		return DiffChanged, true
+	default:
+		return "", false
+	}
+}
+
+type (
+	diffGenesis struct {
+		balances   map[string]sdk.Coins
+		vesting    map[string]VestingSchedule
+		validators map[string]diffValidator
+		params     map[string]string
+	}
+
+	diffValidator struct {
+		Moniker        string
+		SelfDelegation sdk.Coin
+	}
+)
+
+// shares returns the share of the voting power of the validators in percent.
+func (g diffGenesis) shares() map[string]float64 {
+	total := sdk.ZeroInt()
+	for _, validator := range g.validators {
+		total = total.Add(validator.SelfDelegation.Amount)
+	}
+
+	shares := make(map[string]float64)
+	if total.IsZero() {
+		return shares
+	}
+	for address, validator := range g.validators {
+		share := new(big.Float).Quo(
+			new(big.Float).SetInt(validator.SelfDelegation.Amount.BigInt()),
+			new(big.Float).SetInt(total.BigInt()),
+		)
+		shares[address], _ = share.Mul(share, big.NewFloat(100)).Float64()
+	}
+	return shares
+}
+
+// parseDiffGenesis parses the entries of a genesis that are compared by DiffGenesis.
+func parseDiffGenesis(genesis []byte) (diffGenesis, error) {
+	var state struct {
+		ConsensusParams json.RawMessage            `json:"consensus_params"`
+		AppState        map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &state); err != nil {
+		return diffGenesis{}, errors.Wrap(err, "cannot unmarshal the genesis")
+	}
+
+	g := diffGenesis{
+		balances:   make(map[string]sdk.Coins),
+		vesting:    make(map[string]VestingSchedule),
+		validators: make(map[string]diffValidator),
+		params:     make(map[string]string),
+	}
+
+	var bank struct {
+		Balances []struct {
+			Address string    `json:"address"`
+			Coins   sdk.Coins `json:"coins"`
+		} `json:"balances"`
+	}
+	if err := unmarshalModule(state.AppState, "bank", &bank); err != nil {
+		return diffGenesis{}, err
+	}
+	for _, balance := range bank.Balances {
+		g.balances[balance.Address] = balance.Coins
+	}
+
+	var auth struct {
+		Accounts []struct {
+			BaseVestingAccount *struct {
+				BaseAccount struct {
+					Address string `json:"address"`
+				} `json:"base_account"`
+				OriginalVesting sdk.Coins `json:"original_vesting"`
+				EndTime         int64     `json:"end_time,string"`
+			} `json:"base_vesting_account"`
+			StartTime int64 `json:"start_time,string"`
+		} `json:"accounts"`
+	}
+	if err := unmarshalModule(state.AppState, "auth", &auth); err != nil {
+		return diffGenesis{}, err
+	}
+	for _, account := range auth.Accounts {
+		if account.BaseVestingAccount == nil {
+			continue
+		}
+		g.vesting[account.BaseVestingAccount.BaseAccount.Address] = VestingSchedule{
+			OriginalVesting: account.BaseVestingAccount.OriginalVesting,
+			StartTime:       account.StartTime,
+			EndTime:         account.BaseVestingAccount.EndTime,
+		}
+	}
+
+	var genutil struct {
+		GenTxs []struct {
+			Body struct {
+				Messages []struct {
+					Type        string `json:"@type"`
+					Description struct {
+						Moniker string `json:"moniker"`
+					} `json:"description"`
+					ValidatorAddress string   `json:"validator_address"`
+					Value            sdk.Coin `json:"value"`
+				} `json:"messages"`
+			} `json:"body"`
+		} `json:"gen_txs"`
+	}
+	if err := unmarshalModule(state.AppState, "genutil", &genutil); err != nil {
+		return diffGenesis{}, err
+	}
+	for _, gentx := range genutil.GenTxs {
+		for _, msg := range gentx.Body.Messages {
+			if msg.Type != msgCreateValidatorType {
+				continue
+			}
+			g.validators[msg.ValidatorAddress] = diffValidator{
+				Moniker:        msg.Description.Moniker,
+				SelfDelegation: msg.Value,
+			}
+		}
+	}
+
+	if len(state.ConsensusParams) > 0 {
+		flattenParams(g.params, "consensus_params", state.ConsensusParams)
+	}
+	for module, raw := range state.AppState {
+		var moduleState map[string]json.RawMessage
+		// modules with a state that is not an object have no params.
+		if err := json.Unmarshal(raw, &moduleState); err != nil {
+			continue
+		}
+		if params, ok := moduleState["params"]; ok {
+			flattenParams(g.params, module+".params", params)
+		}
+	}
+
+	return g, nil
+}
+
+// unmarshalModule unmarshals the state of a module from the app state when it is set.
+func unmarshalModule(appState map[string]json.RawMessage, module string, v interface{}) error {
+	raw, ok := appState[module]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return errors.Wrapf(err, "cannot unmarshal the %s genesis state", module)
+	}
+	return nil
+}
+
+// flattenParams adds the values of the params to flat with their dot separated path
+// as key, arrays are kept as values.
+func flattenParams(flat map[string]string, prefix string, raw json.RawMessage) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err == nil && len(object) > 0 {
+		for key, value := range object {
+			flattenParams(flat, prefix+"."+key, value)
+		}
+		return
+	}
+
+	var value bytes.Buffer
+	if err := json.Compact(&value, raw); err != nil {
+		flat[prefix] = string(raw)
+		return
+	}
+	flat[prefix] = strings.Trim(value.String(), `"`)
+}
+
+// sortedKeys returns the sorted keys of the maps.
+func sortedKeys[T any](maps ...map[string]T) []string {
+	set := make(map[string]struct{})
+	for _, m := range maps {
+		for key := range m {
+			set[key] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,123 @@
+package cosmosutil_test
+
+import (
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+)
+
+func TestDiffGenesis(t *testing.T) {
+	genesis1, err := os.ReadFile("testdata/genesis1.json")
+	require.NoError(t, err)
+	genesis2, err := os.ReadFile("testdata/genesis2.json")
+	require.NoError(t, err)
+
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(amount)))
+	}
+
+	t.Run("same genesis", func(t *testing.T) {
+		diff, err := cosmosutil.DiffGenesis(genesis1, genesis1)
+		require.NoError(t, err)
+		require.True(t, diff.IsEmpty())
+	})
+
+	t.Run("accounts and validators", func(t *testing.T) {
+		diff, err := cosmosutil.DiffGenesis(genesis1, genesis2)
+		require.NoError(t, err)
+		require.Equal(t, []cosmosutil.BalanceDiff{
+			{
+				Kind:    cosmosutil.DiffRemoved,
+				Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+				Before:  stake(95000000),
+			},
+			{
+				Kind:    cosmosutil.DiffAdded,
+				Address: "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
+				After:   stake(95000000),
+			},
+		}, diff.Balances)
+		require.Equal(t, []cosmosutil.ValidatorDiff{
+			{
+				Kind:           cosmosutil.DiffRemoved,
+				Address:        "cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup",
+				Moniker:        "default",
+				SelfDelegation: sdk.NewCoin("stake", sdk.NewInt(95000000)),
+				ShareBefore:    100,
+			},
+			{
+				Kind:           cosmosutil.DiffAdded,
+				Address:        "cosmosvaloper1mmlqwyqk7neqegffp99q86eckpm4pjah5sl2dw",
+				Moniker:        "alice",
+				SelfDelegation: sdk.NewCoin("stake", sdk.NewInt(95000000)),
+				ShareAfter:     100,
+			},
+		}, diff.Validators)
+		require.Empty(t, diff.VestingAccounts)
+		require.Empty(t, diff.Params)
+	})
+
+	t.Run("vesting accounts and params", func(t *testing.T) {
+		before := []byte(`{
+  "consensus_params": {"block": {"max_gas": "-1"}},
+  "app_state": {
+    "auth": {"accounts": [], "params": {"tx_sig_limit": "7"}},
+    "capability": {"index": "1"}
+  }
+}`)
+		after := []byte(`{
+  "consensus_params": {"block": {"max_gas": "1000000"}},
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {
+          "@type": "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+          "base_vesting_account": {
+            "base_account": {"address": "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"},
+            "original_vesting": [{"amount": "1000", "denom": "stake"}],
+            "end_time": "1700000000"
+          }
+        }
+      ],
+      "params": {"tx_sig_limit": "7"}
+    },
+    "mint": {"params": {"mint_denom": "stake"}}
+  }
+}`)
+
+		diff, err := cosmosutil.DiffGenesis(before, after)
+		require.NoError(t, err)
+		require.Equal(t, []cosmosutil.VestingDiff{
+			{
+				Kind:    cosmosutil.DiffAdded,
+				Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+				After: &cosmosutil.VestingSchedule{
+					OriginalVesting: stake(1000),
+					EndTime:         1700000000,
+				},
+			},
+		}, diff.VestingAccounts)
+		require.Equal(t, []cosmosutil.ParamDiff{
+			{
+				Kind:   cosmosutil.DiffChanged,
+				Key:    "consensus_params.block.max_gas",
+				Before: "-1",
+				After:  "1000000",
+			},
+			{
+				Kind:  cosmosutil.DiffAdded,
+				Key:   "mint.params.mint_denom",
+				After: "stake",
+			},
+		}, diff.Params)
+	})
+
+	t.Run("invalid genesis", func(t *testing.T) {
+		_, err := cosmosutil.DiffGenesis(genesis1, []byte("{"))
+		require.Error(t, err)
+	})
+}
@@ -7,9 +7,15 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/tendermint/tendermint/crypto/ed25519"
-
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 var GentxFilename = "gentx.json"
@@ -21,6 +27,11 @@ type (
 		PubKey           ed25519.PubKey
 		SelfDelegation   sdk.Coin
 		Memo             string
+
+		// Moniker, Commission and MinSelfDelegation describe the validator created by the gentx.
+		Moniker           string
+		Commission        stakingtypes.CommissionRates
+		MinSelfDelegation sdk.Int
 	}
 
 	// StargateGentx represents the stargate gentx file
@@ -37,6 +48,15 @@ type (
 					Denom  string `json:"denom"`
 					Amount string `json:"amount"`
 				} `json:"value"`
+				Description struct {
+					Moniker string `json:"moniker"`
+				} `json:"description"`
+				Commission struct {
+					Rate          string `json:"rate"`
+					MaxRate       string `json:"max_rate"`
+					MaxChangeRate string `json:"max_change_rate"`
+				} `json:"commission"`
+				MinSelfDelegation string `json:"min_self_delegation"`
 			} `json:"messages"`
 			Memo string `json:"memo"`
 		} `json:"body"`
@@ -92,5 +112,100 @@ func ParseGentx(gentx []byte) (info GentxInfo, file []byte, err error) {
 		amount,
 	)
 
+	// the description and the commission of the validator are optional to parse minimal gentxs
+	msg := stargateGentx.Body.Messages[0]
+	info.Moniker = msg.Description.Moniker
+	if c := msg.Commission; c.Rate != "" || c.MaxRate != "" || c.MaxChangeRate != "" {
+		if info.Commission, err = ParseCommissionRates(c.Rate, c.MaxRate, c.MaxChangeRate); err != nil {
+			return info, gentx, err
+		}
+	}
+	if msg.MinSelfDelegation != "" {
+		if info.MinSelfDelegation, ok = sdk.NewIntFromString(msg.MinSelfDelegation); !ok {
+			return info, gentx, errors.New("the min self-delegation inside the gentx is invalid")
+		}
+	}
+
 	return info, gentx, nil
 }
+
+// ParseCommissionRates parses the commission rates of a validator, the rates are not validated
+// against each other.
+func ParseCommissionRates(rate, maxRate, maxChangeRate string) (stakingtypes.CommissionRates, error) {
+	var commission stakingtypes.CommissionRates
+	for _, r := range []struct {
+		name  string
+		value string
+		dec   *sdk.Dec
+	}{
+		{"commission rate", rate, &commission.Rate},
+		{"commission max rate", maxRate, &commission.MaxRate},
+		{"commission max change rate", maxChangeRate, &commission.MaxChangeRate},
+	} {
+		var err error
+		if *r.dec, err = sdk.NewDecFromStr(r.value); err != nil {
+			return stakingtypes.CommissionRates{}, fmt.Errorf("invalid %s %s: %w", r.name, r.value, err)
+		}
+	}
+	return commission, nil
+}
+
+// VerifyGentxSignature verifies offline that the gentx is signed by its delegator for the chain ID.
+// gentxs are signed before the genesis so their account number and sequence are zero.
+func VerifyGentxSignature(gentx []byte, chainID string) error {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	decoded, err := txConfig.TxJSONDecoder()(gentx)
+	if err != nil {
+		return fmt.Errorf("cannot decode gentx: %w", err)
+	}
+	tx, ok := decoded.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errors.New("the gentx cannot be verified")
+	}
+
+	// the signatures are checked before they are decoded with their signer infos because
+	// the decoding fails when they don't match.
+	protoTx, ok := decoded.(interface{ GetProtoTx() *txtypes.Tx })
+	if !ok {
+		return errors.New("the gentx cannot be verified")
+	}
+	if len(protoTx.GetProtoTx().Signatures) != len(protoTx.GetProtoTx().GetAuthInfo().GetSignerInfos()) {
+		return errors.New("the gentx is not signed")
+	}
+
+	signers := tx.GetSigners()
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return fmt.Errorf("invalid gentx signatures: %w", err)
+	}
+	if len(sigs) == 0 {
+		return errors.New("the gentx is not signed")
+	}
+	if len(sigs) != len(signers) {
+		return fmt.Errorf("the gentx has %d signature(s) for %d signer(s)", len(sigs), len(signers))
+	}
+
+	for i, sig := range sigs {
+		if sig.PubKey == nil {
+			return errors.New("the gentx has no signer public key")
+		}
+		if !signers[i].Equals(sdk.AccAddress(sig.PubKey.Address())) {
+			return fmt.Errorf("the gentx signer public key doesn't match the delegator %s", signers[i])
+		}
+
+		signerData := authsigning.SignerData{
+			ChainID:       chainID,
+			AccountNumber: 0,
+			Sequence:      0,
+		}
+		if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), tx); err != nil {
+			return fmt.Errorf("invalid gentx signature for chain %s", chainID)
+		}
+	}
+
+	return nil
+}
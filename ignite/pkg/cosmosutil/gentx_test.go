@@ -1,10 +1,13 @@
 package cosmosutil_test
 
 import (
+	"bytes"
 	"encoding/base64"
+	"os"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
@@ -33,7 +36,14 @@ func TestParseGentx(t *testing.T) {
 					Denom:  "stake",
 					Amount: sdk.NewInt(95000000),
 				},
-				Memo: "9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
+				Memo:    "9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
+				Moniker: "default",
+				Commission: stakingtypes.CommissionRates{
+					Rate:          sdk.MustNewDecFromStr("0.1"),
+					MaxRate:       sdk.MustNewDecFromStr("0.2"),
+					MaxChangeRate: sdk.MustNewDecFromStr("0.01"),
+				},
+				MinSelfDelegation: sdk.NewInt(1),
 			},
 		}, {
 			name:      "parse gentx file 2",
@@ -45,7 +55,14 @@ func TestParseGentx(t *testing.T) {
 					Denom:  "stake",
 					Amount: sdk.NewInt(95000000),
 				},
-				Memo: "a412c917cb29f73cc3ad0592bbd0152fe0e690bd@192.168.0.148:26656",
+				Memo:    "a412c917cb29f73cc3ad0592bbd0152fe0e690bd@192.168.0.148:26656",
+				Moniker: "alice",
+				Commission: stakingtypes.CommissionRates{
+					Rate:          sdk.MustNewDecFromStr("0.1"),
+					MaxRate:       sdk.MustNewDecFromStr("0.2"),
+					MaxChangeRate: sdk.MustNewDecFromStr("0.01"),
+				},
+				MinSelfDelegation: sdk.NewInt(1),
 			},
 		}, {
 			name:      "parse invalid file",
@@ -69,3 +86,67 @@ func TestParseGentx(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCommissionRates(t *testing.T) {
+	commission, err := cosmosutil.ParseCommissionRates("0.1", "0.2", "0.01")
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.CommissionRates{
+		Rate:          sdk.MustNewDecFromStr("0.1"),
+		MaxRate:       sdk.MustNewDecFromStr("0.2"),
+		MaxChangeRate: sdk.MustNewDecFromStr("0.01"),
+	}, commission)
+
+	_, err = cosmosutil.ParseCommissionRates("0.1", "ten", "0.01")
+	require.ErrorContains(t, err, "invalid commission max rate ten")
+
+	_, err = cosmosutil.ParseCommissionRates("0.1", "0.2", "")
+	require.Error(t, err)
+}
+
+func TestVerifyGentxSignature(t *testing.T) {
+	gentx, err := os.ReadFile("testdata/gentx1.json")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		gentx   []byte
+		chainID string
+		wantErr bool
+	}{
+		{
+			name:    "valid signature",
+			gentx:   gentx,
+			chainID: "earth-1",
+		}, {
+			name:    "other chain id",
+			gentx:   gentx,
+			chainID: "mars-1",
+			wantErr: true,
+		}, {
+			name:    "modified gentx",
+			gentx:   bytes.Replace(gentx, []byte(`"moniker": "default"`), []byte(`"moniker": "other"`), 1),
+			chainID: "earth-1",
+			wantErr: true,
+		}, {
+			name:    "unsigned gentx",
+			gentx:   bytes.Replace(gentx, []byte(`"sz0uixBOHJoZbvVrz670vLBRQ5Z2wnhHeNRxKJPz5dADKfz34/sg7FQv6nCeEomODMrgjUD70YBeguKIqxjcLw=="`), nil, 1),
+			chainID: "earth-1",
+			wantErr: true,
+		}, {
+			name:    "invalid gentx",
+			gentx:   []byte("{"),
+			chainID: "earth-1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cosmosutil.VerifyGentxSignature(tt.gentx, tt.chainID)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
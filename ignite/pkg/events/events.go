@@ -23,6 +23,10 @@ type (
 
 		// Icon of the text.
 		Icon string
+
+		// Payload is the typed data of the state for the consumers
+		// that don't display the event as text.
+		Payload interface{}
 	}
 
 	// Status shows if state is ongoing or completed.
@@ -38,6 +42,20 @@ const (
 	StatusNeutral
 )
 
+// String returns the name of the status.
+func (s Status) String() string {
+	switch s {
+	case StatusOngoing:
+		return "ongoing"
+	case StatusDone:
+		return "done"
+	case StatusNeutral:
+		return "neutral"
+	default:
+		return "unknown"
+	}
+}
+
 // TextColor sets the text color
 func TextColor(c color.Color) Option {
 	return func(e *Event) {
@@ -52,6 +70,13 @@ func Icon(icon string) Option {
 	}
 }
 
+// Payload sets the typed data of the event.
+func Payload(payload interface{}) Option {
+	return func(e *Event) {
+		e.Payload = payload
+	}
+}
+
 // New creates a new event with given config.
 func New(status Status, description string, options ...Option) Event {
 	ev := Event{Status: status, Description: description}
@@ -200,3 +200,11 @@ func TestNewBus(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWithPayload(t *testing.T) {
+	payload := struct{ ID uint64 }{ID: 3}
+
+	event := events.New(events.StatusDone, "description", events.Payload(payload))
+	require.Equal(t, payload, event.Payload)
+	require.Equal(t, "done", event.Status.String())
+}
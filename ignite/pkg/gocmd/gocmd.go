@@ -34,6 +34,7 @@ const (
 	FlagMod              = "-mod"
 	FlagModValueReadOnly = "readonly"
 	FlagLdflags          = "-ldflags"
+	FlagTrimpath         = "-trimpath"
 	FlagOut              = "-o"
 )
 
@@ -0,0 +1,206 @@
+package protoanalysis
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BreakingChange is a change made to proto definitions that breaks wire
+// compatibility for existing clients.
+type BreakingChange struct {
+	// Path of the proto file or package where the changed definition lives.
+	Path string
+
+	// Description explains what has been changed.
+	Description string
+}
+
+func (c BreakingChange) String() string {
+	return fmt.Sprintf("%s: %s", c.Path, c.Description)
+}
+
+// FindBreakingChanges compares the proto packages before and after a change and
+// returns the list of changes that break wire compatibility.
+// Removed messages, removed fields, fields with changed numbers or types,
+// and removed or renamed RPC funcs are reported.
+func FindBreakingChanges(before, after Packages) (changes []BreakingChange) {
+	afterPkgs := make(map[string]Package)
+	for _, pkg := range after {
+		afterPkgs[pkg.Name] = pkg
+	}
+
+	for _, oldPkg := range before {
+		newPkg := afterPkgs[oldPkg.Name]
+
+		for _, oldMsg := range oldPkg.Messages {
+			newMsg, err := newPkg.MessageByName(oldMsg.Name)
+			if err != nil {
+				changes = append(changes, BreakingChange{
+					Path:        oldMsg.Path,
+					Description: fmt.Sprintf("message %s.%s is removed", oldPkg.Name, oldMsg.Name),
+				})
+				continue
+			}
+
+			changes = append(changes, compareFields(oldPkg.Name, oldMsg, newMsg)...)
+		}
+
+		for _, oldService := range oldPkg.Services {
+			changes = append(changes, compareServices(oldPkg, oldService, newPkg.serviceByName(oldService.Name))...)
+		}
+	}
+
+	return changes
+}
+
+func compareFields(pkgName string, before, after Message) (changes []BreakingChange) {
+	for _, oldField := range before.Fields {
+		name := fmt.Sprintf("%s.%s.%s", pkgName, before.Name, oldField.Name)
+
+		newField, ok := after.fieldByName(oldField.Name)
+		if !ok {
+			// renaming a field doesn't break the wire format as long as
+			// the number and the type stay the same.
+			if f, ok := after.fieldByNumber(oldField.Number); ok && sameFieldType(pkgName, f, oldField) {
+				continue
+			}
+
+			changes = append(changes, BreakingChange{
+				Path:        after.Path,
+				Description: fmt.Sprintf("field %s is removed", name),
+			})
+			continue
+		}
+
+		if newField.Number != oldField.Number {
+			changes = append(changes, BreakingChange{
+				Path:        after.Path,
+				Description: fmt.Sprintf("field %s number is changed from %d to %d", name, oldField.Number, newField.Number),
+			})
+		}
+
+		if !sameFieldType(pkgName, newField, oldField) {
+			changes = append(changes, BreakingChange{
+				Path:        after.Path,
+				Description: fmt.Sprintf("field %s type is changed from %s to %s", name, oldField.typeName(), newField.typeName()),
+			})
+		}
+	}
+
+	return changes
+}
+
+func compareServices(pkg Package, before Service, after Service) (changes []BreakingChange) {
+	for _, oldFunc := range before.RPCFuncs {
+		if _, ok := after.rpcFuncByName(oldFunc.Name); ok {
+			continue
+		}
+
+		name := fmt.Sprintf("%s.%s/%s", pkg.Name, before.Name, oldFunc.Name)
+
+		// an RPC func that is added with the same request and response types
+		// is considered as a rename of the removed one.
+		description := fmt.Sprintf("rpc %s is removed", name)
+		for _, newFunc := range after.RPCFuncs {
+			if _, ok := before.rpcFuncByName(newFunc.Name); ok {
+				continue
+			}
+			if sameType(pkg.Name, newFunc.RequestType, oldFunc.RequestType) &&
+				sameType(pkg.Name, newFunc.ReturnsType, oldFunc.ReturnsType) {
+				description = fmt.Sprintf("rpc %s is renamed to %s", name, newFunc.Name)
+				break
+			}
+		}
+
+		changes = append(changes, BreakingChange{
+			Path:        pkg.Path,
+			Description: description,
+		})
+	}
+
+	return changes
+}
+
+func (p Package) serviceByName(name string) Service {
+	for _, s := range p.Services {
+		if s.Name == name {
+			return s
+		}
+	}
+	return Service{Name: name}
+}
+
+func (s Service) rpcFuncByName(name string) (RPCFunc, bool) {
+	for _, f := range s.RPCFuncs {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return RPCFunc{}, false
+}
+
+func (m Message) fieldByName(name string) (Field, bool) {
+	for _, f := range m.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+func (m Message) fieldByNumber(number int) (Field, bool) {
+	for _, f := range m.Fields {
+		if f.Number == number {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+func (f Field) typeName() string {
+	if f.Repeated {
+		return "repeated " + f.Type
+	}
+	return f.Type
+}
+
+// scalarTypes are the proto scalar value types.
+var scalarTypes = map[string]bool{
+	"double": true, "float": true, "int32": true, "int64": true, "uint32": true, "uint64": true,
+	"sint32": true, "sint64": true, "fixed32": true, "fixed64": true, "sfixed32": true, "sfixed64": true,
+	"bool": true, "string": true, "bytes": true,
+}
+
+// sameFieldType checks if the fields a and b of a message in the package have the same type.
+func sameFieldType(pkgName string, a, b Field) bool {
+	return a.Repeated == b.Repeated && sameType(pkgName, a.Type, b.Type)
+}
+
+// sameType checks if the type names a and b used in the package refer to the same type.
+func sameType(pkgName, a, b string) bool {
+	return qualifyTypeName(pkgName, a) == qualifyTypeName(pkgName, b)
+}
+
+// qualifyTypeName returns the type name used in the package with its package name so that
+// a local name and a package-qualified name of the same type are equal, e.g. Coin and
+// cosmos.base.v1beta1.Coin in the cosmos.base.v1beta1 package.
+func qualifyTypeName(pkgName, name string) string {
+	name = strings.TrimPrefix(name, ".")
+
+	if strings.HasPrefix(name, "map<") && strings.HasSuffix(name, ">") {
+		kv := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(name, "map<"), ">"), ",", 2)
+		if len(kv) == 2 {
+			return fmt.Sprintf("map<%s, %s>",
+				qualifyTypeName(pkgName, strings.TrimSpace(kv[0])),
+				qualifyTypeName(pkgName, strings.TrimSpace(kv[1])),
+			)
+		}
+	}
+
+	// types with a dot are considered as qualified since the scopes of the nested
+	// types can't be resolved without all the definitions.
+	if scalarTypes[name] || strings.Contains(name, ".") {
+		return name
+	}
+	return pkgName + "." + name
+}
@@ -0,0 +1,122 @@
+package protoanalysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindBreakingChanges(t *testing.T) {
+	before := Packages{
+		{
+			Name: "mars.mars",
+			Path: "proto/mars",
+			Messages: []Message{
+				{
+					Name: "MsgCreatePost",
+					Path: "proto/mars/tx.proto",
+					Fields: []Field{
+						{Name: "creator", Number: 1, Type: "string"},
+						{Name: "title", Number: 2, Type: "string"},
+						{Name: "body", Number: 3, Type: "string"},
+						{Name: "tags", Number: 4, Type: "string", Repeated: true},
+						{Name: "votes", Number: 5, Type: "uint64"},
+						{Name: "likes", Number: 6, Type: "uint64"},
+					},
+				},
+				{Name: "MsgCreatePostResponse", Path: "proto/mars/tx.proto"},
+				{Name: "Post", Path: "proto/mars/post.proto"},
+			},
+			Services: []Service{
+				{
+					Name: "Msg",
+					RPCFuncs: []RPCFunc{
+						{Name: "CreatePost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+						{Name: "DeletePost", RequestType: "MsgDeletePost", ReturnsType: "MsgDeletePostResponse"},
+					},
+				},
+			},
+		},
+	}
+
+	after := Packages{
+		{
+			Name: "mars.mars",
+			Path: "proto/mars",
+			Messages: []Message{
+				{
+					Name: "MsgCreatePost",
+					Path: "proto/mars/tx.proto",
+					Fields: []Field{
+						{Name: "creator", Number: 1, Type: "string"},
+						{Name: "title", Number: 7, Type: "string"},
+						{Name: "body", Number: 3, Type: "bytes"},
+						{Name: "tags", Number: 4, Type: "string"},
+						{Name: "vote_count", Number: 5, Type: "uint64"},
+					},
+				},
+				{Name: "MsgCreatePostResponse", Path: "proto/mars/tx.proto"},
+			},
+			Services: []Service{
+				{
+					Name: "Msg",
+					RPCFuncs: []RPCFunc{
+						{Name: "NewPost", RequestType: "MsgCreatePost", ReturnsType: "MsgCreatePostResponse"},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, []BreakingChange{
+		{Path: "proto/mars/tx.proto", Description: "field mars.mars.MsgCreatePost.title number is changed from 2 to 7"},
+		{Path: "proto/mars/tx.proto", Description: "field mars.mars.MsgCreatePost.body type is changed from string to bytes"},
+		{Path: "proto/mars/tx.proto", Description: "field mars.mars.MsgCreatePost.tags type is changed from repeated string to string"},
+		{Path: "proto/mars/tx.proto", Description: "field mars.mars.MsgCreatePost.likes is removed"},
+		{Path: "proto/mars/post.proto", Description: "message mars.mars.Post is removed"},
+		{Path: "proto/mars", Description: "rpc mars.mars.Msg/CreatePost is renamed to NewPost"},
+		{Path: "proto/mars", Description: "rpc mars.mars.Msg/DeletePost is removed"},
+	}, FindBreakingChanges(before, after))
+
+	require.Empty(t, FindBreakingChanges(after, after))
+}
+
+func TestFindBreakingChangesQualifiedTypes(t *testing.T) {
+	pkg := func(postType, coinType, requestType string) Packages {
+		return Packages{
+			{
+				Name: "mars.mars",
+				Path: "proto/mars",
+				Messages: []Message{
+					{
+						Name: "MsgCreatePost",
+						Path: "proto/mars/tx.proto",
+						Fields: []Field{
+							{Name: "post", Number: 1, Type: postType},
+							{Name: "fees", Number: 2, Type: coinType, Repeated: true},
+							{Name: "labels", Number: 3, Type: "map<string, " + postType + ">"},
+						},
+					},
+				},
+				Services: []Service{
+					{
+						Name: "Msg",
+						RPCFuncs: []RPCFunc{
+							{Name: "CreatePost", RequestType: requestType, ReturnsType: "MsgCreatePostResponse"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	before := pkg("Post", "cosmos.base.v1beta1.Coin", "MsgCreatePost")
+	after := pkg("mars.mars.Post", ".cosmos.base.v1beta1.Coin", ".mars.mars.MsgCreatePost")
+	require.Empty(t, FindBreakingChanges(before, after))
+
+	after = pkg("Comment", "cosmos.base.v1beta1.Coin", "MsgCreatePost")
+	require.Equal(t, []BreakingChange{
+		{Path: "proto/mars/tx.proto", Description: "field mars.mars.MsgCreatePost.post type is changed from Post to Comment"},
+		{Path: "proto/mars/tx.proto", Description: "field mars.mars.MsgCreatePost.labels type is changed from map<string, Post> to map<string, Comment>"},
+	}, FindBreakingChanges(before, after))
+}
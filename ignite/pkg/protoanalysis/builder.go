@@ -82,6 +82,9 @@ func (b builder) buildMessages() (messages []Message) {
 				Name:               name,
 				Path:               f.path,
 				HighestFieldNumber: highestFieldNumber,
+				Fields:             b.elementsToFields(message.Elements),
+				Signers:            b.elementsToSigners(message.Elements),
+				Comment:            commentText(message.Comment),
 			})
 		}
 	}
@@ -89,11 +92,59 @@ func (b builder) buildMessages() (messages []Message) {
 	return messages
 }
 
+func (b builder) elementsToFields(elems []proto.Visitee) (fields []Field) {
+	for _, el := range elems {
+		switch f := el.(type) {
+		case *proto.NormalField:
+			fields = append(fields, Field{
+				Name:     f.Name,
+				Number:   f.Sequence,
+				Type:     f.Type,
+				Repeated: f.Repeated,
+				Comment:  commentText(f.Comment),
+			})
+		case *proto.MapField:
+			fields = append(fields, Field{
+				Name:    f.Name,
+				Number:  f.Sequence,
+				Type:    fmt.Sprintf("map<%s, %s>", f.KeyType, f.Type),
+				Comment: commentText(f.Comment),
+			})
+		case *proto.Oneof:
+			// fields of a oneof are encoded as regular fields of the message.
+			fields = append(fields, b.elementsToFields(f.Elements)...)
+		case *proto.OneOfField:
+			fields = append(fields, Field{
+				Name:    f.Name,
+				Number:  f.Sequence,
+				Type:    f.Type,
+				Comment: commentText(f.Comment),
+			})
+		}
+	}
+
+	return fields
+}
+
+func (b builder) elementsToSigners(elems []proto.Visitee) (signers []string) {
+	for _, el := range elems {
+		option, ok := el.(*proto.Option)
+		if !ok || option.Name != optionMsgSigner {
+			continue
+		}
+
+		signers = append(signers, option.Constant.Source)
+	}
+
+	return signers
+}
+
 func (b builder) toServices(ps []*proto.Service) (services []Service) {
 	for _, service := range ps {
 		s := Service{
 			Name:     service.Name,
 			RPCFuncs: b.elementsToRPCFunc(service.Elements),
+			Comment:  commentText(service.Comment),
 		}
 
 		services = append(services, s)
@@ -127,6 +178,7 @@ func (b builder) elementsToRPCFunc(elems []proto.Visitee) (rpcFuncs []RPCFunc) {
 			RequestType: rpc.RequestType,
 			ReturnsType: rpc.ReturnsType,
 			HTTPRules:   b.elementsToHTTPRules(requestMessage, rpc.Elements),
+			Comment:     commentText(rpc.Comment),
 		}
 
 		rpcFuncs = append(rpcFuncs, rf)
@@ -145,7 +197,14 @@ func (b builder) elementsToHTTPRules(requestMessage *proto.Message, elems []prot
 			continue
 		}
 
-		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, option.Constant)...)
+		// the method is a part of the option name when the endpoint is set directly,
+		// e.g. option (google.api.http).get = "/mars/posts".
+		var method string
+		if i := strings.LastIndex(option.Name, ")."); i != -1 {
+			method = strings.ToUpper(option.Name[i+2:])
+		}
+
+		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, method, option.Constant)...)
 	}
 
 	return
@@ -153,8 +212,8 @@ func (b builder) elementsToHTTPRules(requestMessage *proto.Message, elems []prot
 
 var urlParamRe = regexp.MustCompile(`(?m){(.+?)}`)
 
-func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant proto.Literal) (httpRules []HTTPRule) {
-	// find out the endpoint template.
+func (b builder) constantToHTTPRules(requestMessage *proto.Message, method string, constant proto.Literal) (httpRules []HTTPRule) {
+	// find out the endpoint template and its method.
 	endpoint := constant.Source
 
 	if endpoint == "" {
@@ -167,6 +226,7 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 				"patch",
 				"delete":
 				endpoint = val.Source
+				method = strings.ToUpper(key)
 			}
 			if endpoint != "" {
 				break
@@ -201,6 +261,8 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 
 	// create and add the HTTP rule to the list.
 	httpRule := HTTPRule{
+		Method:   method,
+		Endpoint: endpoint,
 		Params:   params,
 		HasQuery: queryParamsCount > 0,
 		HasBody:  bodyFieldsCount > 0,
@@ -210,7 +272,7 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 
 	// search for nested HTTP rules.
 	if constant, ok := constant.Map["additional_bindings"]; ok {
-		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, *constant)...)
+		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, "", *constant)...)
 	}
 
 	return httpRules
@@ -229,3 +291,17 @@ func (b builder) messageFieldsCount(message *proto.Message) (count int) {
 
 	return
 }
+
+// commentText returns the text of a comment without the comment markers.
+func commentText(c *proto.Comment) string {
+	if c == nil {
+		return ""
+	}
+
+	lines := make([]string, len(c.Lines))
+	for i, line := range c.Lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -81,6 +81,36 @@ type Message struct {
 	// HighestFieldNumber is the highest field number among fields of the message
 	// This allows to determine new field number when writing to proto message
 	HighestFieldNumber int
+
+	// Fields is a list of fields defined in the message, including the ones
+	// inside oneofs.
+	Fields []Field
+
+	// Signers is a list of field names set with the cosmos.msg.v1.signer option
+	// which hold the addresses of the Msg signers.
+	Signers []string
+
+	// Comment is the leading comment of the message.
+	Comment string
+}
+
+// Field represents a field of a proto message.
+type Field struct {
+	// Name of the field.
+	Name string
+
+	// Number is the field number used to identify the field in the wire format.
+	Number int
+
+	// Type of the field as written in the proto file, e.g. string or cosmos.base.v1beta1.Coin.
+	// Map fields have a type in the form of map<key, value>.
+	Type string
+
+	// Repeated indicates if the field is a list of values.
+	Repeated bool
+
+	// Comment is the leading comment of the field.
+	Comment string
 }
 
 // Service is an RPC service.
@@ -90,6 +120,9 @@ type Service struct {
 
 	// RPC is a list of RPC funcs of the service.
 	RPCFuncs []RPCFunc
+
+	// Comment is the leading comment of the service.
+	Comment string
 }
 
 // RPCFunc is an RPC func.
@@ -107,10 +140,19 @@ type RPCFunc struct {
 	// spec:
 	//   https://github.com/googleapis/googleapis/blob/master/google/api/http.proto.
 	HTTPRules []HTTPRule
+
+	// Comment is the leading comment of the RPC func.
+	Comment string
 }
 
 // HTTPRule keeps info about a configured http rule of an RPC func.
 type HTTPRule struct {
+	// Method is the HTTP method of the endpoint, e.g. GET or POST.
+	Method string
+
+	// Endpoint is the path template of the endpoint, e.g. /mars/posts/{id}.
+	Endpoint string
+
 	// Params is a list of parameters defined in the http endpoint itself.
 	Params []string
 
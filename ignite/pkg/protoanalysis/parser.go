@@ -11,7 +11,10 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/localfs"
 )
 
-const optionGoPkg = "go_package"
+const (
+	optionGoPkg     = "go_package"
+	optionMsgSigner = "(cosmos.msg.v1.signer)"
+)
 
 // parser parses proto packages.
 type parser struct {
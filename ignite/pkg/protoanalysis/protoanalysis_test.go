@@ -49,55 +49,535 @@ func TestLiquidity(t *testing.T) {
 			},
 			GoImportName: "github.com/tendermint/liquidity/x/liquidity/types",
 			Messages: []Message{
-				{Name: "PoolRecord", Path: "testdata/liquidity/genesis.proto", HighestFieldNumber: 6},
-				{Name: "GenesisState", Path: "testdata/liquidity/genesis.proto", HighestFieldNumber: 2},
-				{Name: "PoolType", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 5},
-				{Name: "Params", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 9},
-				{Name: "Pool", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 5},
-				{Name: "PoolMetadata", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 3},
-				{Name: "PoolMetadataResponse", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 2},
-				{Name: "PoolBatch", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 7},
-				{Name: "PoolBatchResponse", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 6},
-				{Name: "DepositMsgState", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 6},
-				{Name: "WithdrawMsgState", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 6},
-				{Name: "SwapMsgState", Path: "testdata/liquidity/liquidity.proto", HighestFieldNumber: 10},
-				{Name: "QueryLiquidityPoolRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolBatchRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolBatchResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryLiquidityPoolsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryParamsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 0},
-				{Name: "QueryParamsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryPoolBatchSwapMsgsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchSwapMsgRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchSwapMsgsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchSwapMsgResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryPoolBatchDepositMsgsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchDepositMsgRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchDepositMsgsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchDepositMsgResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "QueryPoolBatchWithdrawMsgsRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchWithdrawMsgRequest", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchWithdrawMsgsResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 2},
-				{Name: "QueryPoolBatchWithdrawMsgResponse", Path: "testdata/liquidity/query.proto", HighestFieldNumber: 1},
-				{Name: "MsgCreatePool", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 4},
-				{Name: "MsgCreatePoolRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 2},
-				{Name: "MsgCreatePoolResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "MsgDepositWithinBatch", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgDepositWithinBatchRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgDepositWithinBatchResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "MsgWithdrawWithinBatch", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgWithdrawWithinBatchRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgWithdrawWithinBatchResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "MsgSwapWithinBatch", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 7},
-				{Name: "MsgSwapWithinBatchRequest", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 3},
-				{Name: "MsgSwapWithinBatchResponse", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 1},
-				{Name: "BaseReq", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 11},
-				{Name: "Fee", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 2},
-				{Name: "PubKey", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 2},
-				{Name: "Signature", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 4},
-				{Name: "StdTx", Path: "testdata/liquidity/tx.proto", HighestFieldNumber: 4},
+				{
+					Name:               "PoolRecord",
+					Path:               "testdata/liquidity/genesis.proto",
+					HighestFieldNumber: 6,
+					Fields: []Field{
+						{Name: "pool", Number: 1, Type: "Pool"},
+						{Name: "pool_metadata", Number: 2, Type: "PoolMetadata"},
+						{Name: "pool_batch", Number: 3, Type: "PoolBatch"},
+						{Name: "deposit_msg_states", Number: 4, Type: "DepositMsgState", Repeated: true},
+						{Name: "withdraw_msg_states", Number: 5, Type: "WithdrawMsgState", Repeated: true},
+						{Name: "swap_msg_states", Number: 6, Type: "SwapMsgState", Repeated: true},
+					},
+				},
+				{
+					Name:               "GenesisState",
+					Path:               "testdata/liquidity/genesis.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "params", Number: 1, Type: "Params", Comment: "params defines all the parameters of related to liquidity."},
+						{Name: "pool_records", Number: 2, Type: "PoolRecord", Repeated: true},
+					},
+					Comment: "GenesisState defines the liquidity module's genesis state.",
+				},
+				{
+					Name:               "PoolType",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 5,
+					Fields: []Field{
+						{Name: "id", Number: 1, Type: "uint32", Comment: "id of target pool type, only 1 is allowed on this version."},
+						{Name: "name", Number: 2, Type: "string", Comment: "name of the pool type"},
+						{Name: "min_reserve_coin_num", Number: 3, Type: "uint32", Comment: "min number of reserveCoins for LiquidityPoolType only 2 is allowed on this spec"},
+						{Name: "max_reserve_coin_num", Number: 4, Type: "uint32", Comment: "max number of reserveCoins for LiquidityPoolType only 2 is allowed on this spec"},
+						{Name: "description", Number: 5, Type: "string", Comment: "description of the pool type"},
+					},
+				},
+				{
+					Name:               "Params",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 9,
+					Fields: []Field{
+						{Name: "pool_types", Number: 1, Type: "PoolType", Repeated: true, Comment: "list of available pool types"},
+						{Name: "min_init_deposit_amount", Number: 2, Type: "string", Comment: "Minimum number of coins to be deposited to the liquidity pool upon pool creation"},
+						{Name: "init_pool_coin_mint_amount", Number: 3, Type: "string", Comment: "Initial mint amount of pool coin upon pool creation"},
+						{Name: "max_reserve_coin_amount", Number: 4, Type: "string", Comment: "Limit the size of each liquidity pool in the beginning phase of Liquidity Module adoption to minimize risk, 0 means no limit"},
+						{Name: "pool_creation_fee", Number: 5, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Comment: "Fee paid for new Liquidity Pool creation to prevent spamming"},
+						{Name: "swap_fee_rate", Number: 6, Type: "bytes", Comment: "Swap fee rate for every executed swap"},
+						{Name: "withdraw_fee_rate", Number: 7, Type: "bytes", Comment: "Reserve coin withdrawal with less proportion by withdrawFeeRate"},
+						{Name: "max_order_amount_ratio", Number: 8, Type: "bytes", Comment: "Maximum ratio of reserve coins that can be ordered at a swap order"},
+						{Name: "unit_batch_height", Number: 9, Type: "uint32", Comment: "The smallest unit batch height for every liquidity pool"},
+					},
+				},
+				{
+					Name:               "Pool",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 5,
+					Fields: []Field{
+						{Name: "id", Number: 1, Type: "uint64", Comment: "id of the pool"},
+						{Name: "type_id", Number: 2, Type: "uint32", Comment: "id of the pool type"},
+						{Name: "reserve_coin_denoms", Number: 3, Type: "string", Repeated: true, Comment: "denoms of reserve coin pair of the pool"},
+						{Name: "reserve_account_address", Number: 4, Type: "string", Comment: "reserve account address of the pool"},
+						{Name: "pool_coin_denom", Number: 5, Type: "string", Comment: "denom of pool coin of the pool"},
+					},
+				},
+				{
+					Name:               "PoolMetadata",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 3,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the pool"},
+						{Name: "pool_coin_total_supply", Number: 2, Type: "cosmos.base.v1beta1.Coin", Comment: "pool coin issued at the pool"},
+						{Name: "reserve_coins", Number: 3, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Comment: "reserve coins deposited in the pool"},
+					},
+				},
+				{
+					Name:               "PoolMetadataResponse",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_coin_total_supply", Number: 1, Type: "cosmos.base.v1beta1.Coin", Comment: "pool coin issued at the pool"},
+						{Name: "reserve_coins", Number: 2, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Comment: "reserve coins deposited in the pool"},
+					},
+				},
+				{
+					Name:               "PoolBatch",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 7,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the pool"},
+						{Name: "index", Number: 2, Type: "uint64", Comment: "index of this batch"},
+						{Name: "begin_height", Number: 3, Type: "int64", Comment: "height where this batch is begun"},
+						{Name: "deposit_msg_index", Number: 4, Type: "uint64", Comment: "last index of DepositMsgStates"},
+						{Name: "withdraw_msg_index", Number: 5, Type: "uint64", Comment: "last index of WithdrawMsgStates"},
+						{Name: "swap_msg_index", Number: 6, Type: "uint64", Comment: "last index of SwapMsgStates"},
+						{Name: "executed", Number: 7, Type: "bool", Comment: "true if executed, false if not executed yet"},
+					},
+				},
+				{
+					Name:               "PoolBatchResponse",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 6,
+					Fields: []Field{
+						{Name: "index", Number: 1, Type: "uint64", Comment: "index of this batch"},
+						{Name: "begin_height", Number: 2, Type: "int64", Comment: "height where this batch is begun"},
+						{Name: "deposit_msg_index", Number: 3, Type: "uint64", Comment: "last index of DepositMsgStates"},
+						{Name: "withdraw_msg_index", Number: 4, Type: "uint64", Comment: "last index of WithdrawMsgStates"},
+						{Name: "swap_msg_index", Number: 5, Type: "uint64", Comment: "last index of SwapMsgStates"},
+						{Name: "executed", Number: 6, Type: "bool", Comment: "true if executed, false if not executed yet"},
+					},
+				},
+				{
+					Name:               "DepositMsgState",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 6,
+					Fields: []Field{
+						{Name: "msg_height", Number: 1, Type: "int64", Comment: "height where this message is appended to the batch"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Comment: "index of this deposit message in this liquidity pool"},
+						{Name: "executed", Number: 3, Type: "bool", Comment: "true if executed on this batch, false if not executed yet"},
+						{Name: "succeeded", Number: 4, Type: "bool", Comment: "true if executed successfully on this batch, false if failed"},
+						{Name: "to_be_deleted", Number: 5, Type: "bool", Comment: "true if ready to be deleted on kvstore, false if not ready to be deleted"},
+						{Name: "msg", Number: 6, Type: "MsgDepositWithinBatch", Comment: "MsgDepositWithinBatch"},
+					},
+				},
+				{
+					Name:               "WithdrawMsgState",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 6,
+					Fields: []Field{
+						{Name: "msg_height", Number: 1, Type: "int64", Comment: "height where this message is appended to the batch"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Comment: "index of this withdraw message in this liquidity pool"},
+						{Name: "executed", Number: 3, Type: "bool", Comment: "true if executed on this batch, false if not executed yet"},
+						{Name: "succeeded", Number: 4, Type: "bool", Comment: "true if executed successfully on this batch, false if failed"},
+						{Name: "to_be_deleted", Number: 5, Type: "bool", Comment: "true if ready to be deleted on kvstore, false if not ready to be deleted"},
+						{Name: "msg", Number: 6, Type: "MsgWithdrawWithinBatch", Comment: "MsgWithdrawWithinBatch"},
+					},
+				},
+				{
+					Name:               "SwapMsgState",
+					Path:               "testdata/liquidity/liquidity.proto",
+					HighestFieldNumber: 10,
+					Fields: []Field{
+						{Name: "msg_height", Number: 1, Type: "int64", Comment: "height where this message is appended to the batch"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Comment: "index of this swap message in this liquidity pool"},
+						{Name: "executed", Number: 3, Type: "bool", Comment: "true if executed on this batch, false if not executed yet"},
+						{Name: "succeeded", Number: 4, Type: "bool", Comment: "true if executed successfully on this batch, false if failed"},
+						{Name: "to_be_deleted", Number: 5, Type: "bool", Comment: "true if ready to be deleted on kvstore, false if not ready to be deleted"},
+						{Name: "order_expiry_height", Number: 6, Type: "int64", Comment: "swap orders are cancelled when current height is equal or higher than ExpiryHeight"},
+						{Name: "exchanged_offer_coin", Number: 7, Type: "cosmos.base.v1beta1.Coin", Comment: "offer coin exchanged until now"},
+						{Name: "remaining_offer_coin", Number: 8, Type: "cosmos.base.v1beta1.Coin", Comment: "offer coin currently remaining to be exchanged"},
+						{Name: "reserved_offer_coin_fee", Number: 9, Type: "cosmos.base.v1beta1.Coin", Comment: "reserve fee for pays fee in half offer coin"},
+						{Name: "msg", Number: 10, Type: "MsgSwapWithinBatch", Comment: "MsgSwapWithinBatch"},
+					},
+				},
+				{
+					Name:               "QueryLiquidityPoolRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64"},
+					},
+					Comment: "the request type for the QueryLiquidityPool RPC method. requestable specified pool_id.",
+				},
+				{
+					Name:               "QueryLiquidityPoolResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "pool", Number: 1, Type: "Pool"},
+					},
+					Comment: "the response type for the QueryLiquidityPoolResponse RPC method. It returns the liquidity pool corresponding to the requested pool_id.",
+				},
+				{
+					Name:               "QueryLiquidityPoolBatchRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the target pool for query"},
+					},
+					Comment: "the request type for the QueryLiquidityPoolBatch RPC method. requestable including specified pool_id.",
+				},
+				{
+					Name:               "QueryLiquidityPoolBatchResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "batch", Number: 1, Type: "PoolBatch"},
+					},
+					Comment: "the response type for the QueryLiquidityPoolBatchResponse RPC method. It returns the liquidity pool batch corresponding to the requested pool_id.",
+				},
+				{
+					Name:               "QueryLiquidityPoolsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "pagination", Number: 1, Type: "cosmos.base.query.v1beta1.PageRequest", Comment: "pagination defines an optional pagination for the request."},
+					},
+					Comment: "the request type for the QueryLiquidityPools RPC method. requestable including pagination offset, limit, key.",
+				},
+				{
+					Name:               "QueryLiquidityPoolsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pools", Number: 1, Type: "Pool", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Comment: "pagination defines the pagination in the response. not working on this version."},
+					},
+					Comment: "the response type for the QueryLiquidityPoolsResponse RPC method. This includes list of all liquidity pools currently existed and paging results containing next_key and total count.",
+				},
+				{
+					Name:               "QueryParamsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 0,
+					Comment:            "QueryParamsRequest is request type for the QueryParams RPC method.",
+				},
+				{
+					Name:               "QueryParamsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "params", Number: 1, Type: "Params", Comment: "params holds all the parameters of this module."},
+					},
+					Comment: "the response type for the QueryParamsResponse RPC method. This includes current parameter of the liquidity module.",
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the target pool for query"},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageRequest", Comment: "pagination defines an optional pagination for the request."},
+					},
+					Comment: "the request type for the QueryPoolBatchSwapMsgs RPC method. requestable including specified pool_id and pagination offset, limit, key.",
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the target pool for query"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Comment: "target msg_index of the pool"},
+					},
+					Comment: "the request type for the QueryPoolBatchSwap RPC method. requestable including specified pool_id and msg_index",
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "swaps", Number: 1, Type: "SwapMsgState", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Comment: "pagination defines the pagination in the response. not working on this version."},
+					},
+					Comment: "the response type for the QueryPoolBatchSwapMsgs RPC method. This includes list of all currently existing swap messages of the batch and paging results containing next_key and total count.",
+				},
+				{
+					Name:               "QueryPoolBatchSwapMsgResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "swap", Number: 1, Type: "SwapMsgState"},
+					},
+					Comment: "the response type for the QueryPoolBatchSwapMsg RPC method. This includes a batch swap message of the batch",
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the target pool for query"},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageRequest", Comment: "pagination defines an optional pagination for the request."},
+					},
+					Comment: "the request type for the QueryPoolBatchDeposit RPC method. requestable including specified pool_id and pagination offset, limit, key.",
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the target pool for query"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Comment: "target msg_index of the pool"},
+					},
+					Comment: "the request type for the QueryPoolBatchDeposit RPC method. requestable including specified pool_id and msg_index",
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "deposits", Number: 1, Type: "DepositMsgState", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Comment: "pagination defines the pagination in the response. not working on this version."},
+					},
+					Comment: "the response type for the QueryPoolBatchDeposit RPC method. This includes a list of all currently existing deposit messages of the batch and paging results containing next_key and total count.",
+				},
+				{
+					Name:               "QueryPoolBatchDepositMsgResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "deposit", Number: 1, Type: "DepositMsgState"},
+					},
+					Comment: "the response type for the QueryPoolBatchDepositMsg RPC method. This includes a batch swap message of the batch",
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgsRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the target pool for query"},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageRequest", Comment: "pagination defines an optional pagination for the request."},
+					},
+					Comment: "the request type for the QueryPoolBatchWithdraw RPC method. requestable including specified pool_id and pagination offset, limit, key.",
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgRequest",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "pool_id", Number: 1, Type: "uint64", Comment: "id of the target pool for query"},
+						{Name: "msg_index", Number: 2, Type: "uint64", Comment: "target msg_index of the pool"},
+					},
+					Comment: "the request type for the QueryPoolBatchWithdraw RPC method. requestable including specified pool_id and msg_index",
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgsResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "withdraws", Number: 1, Type: "WithdrawMsgState", Repeated: true},
+						{Name: "pagination", Number: 2, Type: "cosmos.base.query.v1beta1.PageResponse", Comment: "pagination defines the pagination in the response. not working on this version."},
+					},
+					Comment: "the response type for the QueryPoolBatchWithdraw RPC method. This includes a list of all currently existing withdraw messages of the batch and paging results containing next_key and total count.",
+				},
+				{
+					Name:               "QueryPoolBatchWithdrawMsgResponse",
+					Path:               "testdata/liquidity/query.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "withdraw", Number: 1, Type: "WithdrawMsgState"},
+					},
+					Comment: "the response type for the QueryPoolBatchWithdrawMsg RPC method. This includes a batch swap message of the batch",
+				},
+				{
+					Name:               "MsgCreatePool",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 4,
+					Fields: []Field{
+						{Name: "pool_creator_address", Number: 1, Type: "string"},
+						{Name: "pool_type_id", Number: 2, Type: "uint32", Comment: "id of target pool type, only 1 is allowed on this version, Must match the value in the pool."},
+						{Name: "deposit_coins", Number: 4, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Comment: "reserve coin pair of the pool to deposit"},
+					},
+					Comment: "MsgCreatePool defines an sdk.Msg type that supports submitting create liquidity pool",
+				},
+				{
+					Name:               "MsgCreatePoolRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "msg", Number: 2, Type: "MsgCreatePool", Comment: "MsgCreatePool"},
+					},
+					Comment: "MsgCreatePoolRequest is the request type for the Msg/MsgCreatePoolRequest RPC method.",
+				},
+				{
+					Name:               "MsgCreatePoolResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+					Comment: "MsgCreatePoolResponse defines the Msg/CreatePool response type.",
+				},
+				{
+					Name:               "MsgDepositWithinBatch",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 3,
+					Fields: []Field{
+						{Name: "depositor_address", Number: 1, Type: "string", Comment: "The publisher in which to create the book.\n\nFormat: `publishers/{publisher}`\n\nExample: `publishers/1257894000000000000`"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Comment: "id of the target pool"},
+						{Name: "deposit_coins", Number: 3, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Comment: "reserve coin pair of the pool to deposit"},
+					},
+					Comment: "`MsgDepositWithinBatch defines` an `sdk.Msg` type that supports submitting deposit request to the batch of the liquidity pool\nDeposit submit to the batch of the Liquidity pool with the specified `pool_id`, deposit_coins for reserve\nthis requests are stacked in the batch of the liquidity pool, not immediately processed and\nprocessed in the `endblock` at once with other requests.\n\nSee: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md",
+				},
+				{
+					Name:               "MsgDepositWithinBatchRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 3,
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Comment: "id of the target pool"},
+						{Name: "msg", Number: 3, Type: "MsgDepositWithinBatch", Comment: "MsgDepositWithinBatch"},
+					},
+					Comment: "MsgDepositWithinBatchRequest is the request type for the Msg/DepositWithinBatch RPC method.",
+				},
+				{
+					Name:               "MsgDepositWithinBatchResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+					Comment: "MsgDepositWithinBatchResponse defines the Msg/DepositWithinBatch response type.",
+				},
+				{
+					Name:               "MsgWithdrawWithinBatch",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 3,
+					Fields: []Field{
+						{Name: "withdrawer_address", Number: 1, Type: "string"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Comment: "id of the target pool"},
+						{Name: "pool_coin", Number: 3, Type: "cosmos.base.v1beta1.Coin"},
+					},
+					Comment: "`MsgWithdrawWithinBatch` defines an `sdk.Msg` type that supports submitting withdraw request to the batch of the liquidity pool\nWithdraw submit to the batch from the Liquidity pool with the specified `pool_id`, `pool_coin` of the pool\nthis requests are stacked in the batch of the liquidity pool, not immediately processed and\nprocessed in the `endblock` at once with other requests.\n\nSee: https://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md",
+				},
+				{
+					Name:               "MsgWithdrawWithinBatchRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 3,
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Comment: "id of the target pool"},
+						{Name: "msg", Number: 3, Type: "MsgWithdrawWithinBatch", Comment: "MsgWithdrawWithinBatch"},
+					},
+					Comment: "MsgWithdrawWithinBatchRequest is the request type for the Query/WithdrawWithinBatch RPC method.",
+				},
+				{
+					Name:               "MsgWithdrawWithinBatchResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+					Comment: "MsgWithdrawWithinBatchResponse defines the Msg/WithdrawWithinBatch response type.",
+				},
+				{
+					Name:               "MsgSwapWithinBatch",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 7,
+					Fields: []Field{
+						{Name: "swap_requester_address", Number: 1, Type: "string", Comment: "address of swap requester"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Comment: "id of the target pool"},
+						{Name: "swap_type_id", Number: 3, Type: "uint32", Comment: "id of swap type, only 1 is allowed on this version, Must match the value in the pool."},
+						{Name: "offer_coin", Number: 4, Type: "cosmos.base.v1beta1.Coin", Comment: "offer sdk.coin for the swap request, Must match the denom in the pool."},
+						{Name: "demand_coin_denom", Number: 5, Type: "string", Comment: "denom of demand coin to be exchanged on the swap request, Must match the denom in the pool."},
+						{Name: "offer_coin_fee", Number: 6, Type: "cosmos.base.v1beta1.Coin", Comment: "offer coin fee for pay fees in half offer coin"},
+						{Name: "order_price", Number: 7, Type: "bytes", Comment: "limit order price for this offer"},
+					},
+					Comment: "`MsgSwapWithinBatch` defines an sdk.Msg type that supports submitting swap offer request to the batch of the liquidity pool\nSwap offer submit to the batch to the Liquidity pool with the specified the `pool_id`, `swap_type_id`,\n`demand_coin_denom` with the coin and the price you're offering and current `params.swap_fee_rate`\nthis requests are stacked in the batch of the liquidity pool, not immediately processed and\nprocessed in the `endblock` at once with other requests\nYou should request the same each field as the pool\nCurrently, only the default `swap_type_id`1 is available on this version\nThe detailed swap algorithm can be found here.\n\nSee: https://github.com/tendermint/liquidity/tree/develop/doc\nhttps://github.com/tendermint/liquidity/blob/develop/x/liquidity/spec/04_messages.md",
+				},
+				{
+					Name:               "MsgSwapWithinBatchRequest",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 3,
+					Fields: []Field{
+						{Name: "base_req", Number: 1, Type: "BaseReq"},
+						{Name: "pool_id", Number: 2, Type: "uint64", Comment: "id of the target pool"},
+						{Name: "msg", Number: 3, Type: "MsgSwapWithinBatch", Comment: "MsgSwapWithinBatch"},
+					},
+					Comment: "MsgSwapWithinBatchRequest is the request type for the Query/Swap RPC method.",
+				},
+				{
+					Name:               "MsgSwapWithinBatchResponse",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 1,
+					Fields: []Field{
+						{Name: "std_tx", Number: 1, Type: "StdTx"},
+					},
+					Comment: "MsgSwapWithinBatchResponse defines the Msg/Swap response type.",
+				},
+				{
+					Name:               "BaseReq",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 11,
+					Fields: []Field{
+						{Name: "from", Number: 1, Type: "string", Comment: "Sender address or Keybase name to generate a transaction"},
+						{Name: "memo", Number: 2, Type: "string", Comment: "Memo to send along with transaction"},
+						{Name: "chain_id", Number: 3, Type: "string", Comment: "Name or address of private key with which to sign"},
+						{Name: "account_number", Number: 4, Type: "uint64", Comment: "The account number of the signing account (offline mode only)"},
+						{Name: "sequence", Number: 5, Type: "uint64", Comment: "The sequence number of the signing account (offline mode only)"},
+						{Name: "timeout_height", Number: 6, Type: "uint64", Comment: "Set a block timeout height to prevent the tx from being committed past a certain height"},
+						{Name: "fees", Number: 7, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Comment: "Fees to pay along with transaction"},
+						{Name: "gas_prices", Number: 8, Type: "cosmos.base.v1beta1.DecCoin", Repeated: true, Comment: "Gas prices in decimal format to determine the transaction fee"},
+						{Name: "gas", Number: 9, Type: "uint64", Comment: "Gas amount to determine the transaction fee"},
+						{Name: "gas_adjustment", Number: 10, Type: "string", Comment: "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored"},
+						{Name: "simulate", Number: 11, Type: "bool", Comment: "Estimate gas for a transaction (cannot be used in conjunction with generate_only)"},
+					},
+					Comment: "Base Request struct for Post Tx, standard of tendermint/cosmos-sdk",
+				},
+				{
+					Name:               "Fee",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "gas", Number: 1, Type: "uint64"},
+						{Name: "amount", Number: 2, Type: "cosmos.base.v1beta1.Coin", Repeated: true, Comment: "amount is the amount of coins to be paid as a fee"},
+					},
+					Comment: "Fee struct of cosmos-sdk",
+				},
+				{
+					Name:               "PubKey",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 2,
+					Fields: []Field{
+						{Name: "type", Number: 1, Type: "string", Comment: "type of pubkey algorithm"},
+						{Name: "value", Number: 2, Type: "string", Comment: "value of pubkey"},
+					},
+					Comment: "PubKey struct of tendermint/cosmos-sdk",
+				},
+				{
+					Name:               "Signature",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 4,
+					Fields: []Field{
+						{Name: "signature", Number: 1, Type: "string", Comment: "signature base64"},
+						{Name: "pub_key", Number: 2, Type: "PubKey", Comment: "PubKey"},
+						{Name: "account_number", Number: 3, Type: "uint64", Comment: "The account number of the signing account (offline mode only)"},
+						{Name: "sequence", Number: 4, Type: "uint64", Comment: "The sequence number of the signing account (offline mode only)"},
+					},
+					Comment: "signature struct of tendermint/cosmos-sdk",
+				},
+				{
+					Name:               "StdTx",
+					Path:               "testdata/liquidity/tx.proto",
+					HighestFieldNumber: 4,
+					Fields: []Field{
+						{Name: "msg", Number: 1, Type: "string", Repeated: true, Comment: "Msgs"},
+						{Name: "fee", Number: 2, Type: "Fee", Comment: "Fee"},
+						{Name: "memo", Number: 3, Type: "string", Comment: "Memo of the transaction"},
+						{Name: "signature", Number: 4, Type: "Signature", Comment: "Signature"},
+					},
+					Comment: "Base response struct of result of the requested Tx, standard of tendermint/cosmos-sdk",
+				},
 			},
 			Services: []Service{
 				{
@@ -109,10 +589,13 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgCreatePoolResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"test"},
-									HasBody: true,
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{test}",
+									Params:   []string{"test"},
+									HasBody:  true,
 								},
 							},
+							Comment: "Submit create liquidity pool message.",
 						},
 						{
 							Name:        "DepositWithinBatchApi",
@@ -120,10 +603,13 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgDepositWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"pool_id"},
-									HasBody: true,
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/deposits",
+									Params:   []string{"pool_id"},
+									HasBody:  true,
 								},
 							},
+							Comment: "Submit deposit to the liquidity pool batch",
 						},
 						{
 							Name:        "WithdrawWithinBatchApi",
@@ -131,10 +617,13 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgWithdrawWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"pool_id"},
-									HasBody: true,
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/withdraws",
+									Params:   []string{"pool_id"},
+									HasBody:  true,
 								},
 							},
+							Comment: "Submit withdraw from to the liquidity pool batch",
 						},
 						{
 							Name:        "SwapApi",
@@ -142,13 +631,17 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgSwapWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/swaps",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 									HasBody:  true,
 								},
 							},
+							Comment: "Submit swap to the liquidity pool batch",
 						},
 					},
+					Comment: "Msg defines the staking Msg service.",
 				},
 				{
 					Name: "Query",
@@ -159,9 +652,12 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryLiquidityPoolsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools",
 									HasQuery: true,
 								},
 							},
+							Comment: "Get existing liquidity pools.",
 						},
 						{
 							Name:        "LiquidityPool",
@@ -169,9 +665,12 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryLiquidityPoolResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}",
+									Params:   []string{"pool_id"},
 								},
 							},
+							Comment: "Get specific liquidity pool.",
 						},
 						{
 							Name:        "LiquidityPoolBatch",
@@ -179,9 +678,12 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryLiquidityPoolBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch",
+									Params:   []string{"pool_id"},
 								},
 							},
+							Comment: "Get the pool's current batch.",
 						},
 						{
 							Name:        "PoolBatchSwapMsgs",
@@ -189,10 +691,13 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchSwapMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/swaps",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
 							},
+							Comment: "Get all swap messages in the pool's current batch.",
 						},
 						{
 							Name:        "PoolBatchSwapMsg",
@@ -200,9 +705,12 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchSwapMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/swaps/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
+							Comment: "Get specific swap message in the pool's current batch.",
 						},
 						{
 							Name:        "PoolBatchDepositMsgs",
@@ -210,10 +718,13 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchDepositMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/deposits",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
 							},
+							Comment: "Get all deposit messages in the pool's current batch.",
 						},
 						{
 							Name:        "PoolBatchDepositMsg",
@@ -221,9 +732,12 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchDepositMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/deposits/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
+							Comment: "Get specific deposit message in the pool's current batch.",
 						},
 						{
 							Name:        "PoolBatchWithdrawMsgs",
@@ -231,10 +745,13 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchWithdrawMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/withdraws",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
 							},
+							Comment: "Get all withdraw messages in the pool's current batch.",
 						},
 						{
 							Name:        "PoolBatchWithdrawMsg",
@@ -242,19 +759,27 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchWithdrawMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/withdraws/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
+							Comment: "Get specific withdraw message in the pool's current batch.",
 						},
 						{
 							Name:        "Params",
 							RequestType: "QueryParamsRequest",
 							ReturnsType: "QueryParamsResponse",
 							HTTPRules: []HTTPRule{
-								{},
+								{
+									Method:   "GET",
+									Endpoint: "/liquidity/params",
+								},
 							},
+							Comment: "Get all parameters of the liquidity module.",
 						},
 					},
+					Comment: "Query defines the gRPC querier service for liquidity module.",
 				},
 				{
 					Name: "Msg",
@@ -263,23 +788,28 @@ func TestLiquidity(t *testing.T) {
 							Name:        "CreatePool",
 							RequestType: "MsgCreatePool",
 							ReturnsType: "MsgCreatePoolResponse",
+							Comment:     "Submit create liquidity pool message.",
 						},
 						{
 							Name:        "DepositWithinBatch",
 							RequestType: "MsgDepositWithinBatch",
 							ReturnsType: "MsgDepositWithinBatchResponse",
+							Comment:     "Submit deposit to the liquidity pool batch.",
 						},
 						{
 							Name:        "WithdrawWithinBatch",
 							RequestType: "MsgWithdrawWithinBatch",
 							ReturnsType: "MsgWithdrawWithinBatchResponse",
+							Comment:     "Submit withdraw from to the liquidity pool batch.",
 						},
 						{
 							Name:        "Swap",
 							RequestType: "MsgSwapWithinBatch",
 							ReturnsType: "MsgSwapWithinBatchResponse",
+							Comment:     "Submit swap to the liquidity pool batch.",
 						},
 					},
+					Comment: "Msg defines the liquidity Msg service.",
 				},
 			},
 		},
@@ -287,3 +817,19 @@ func TestLiquidity(t *testing.T) {
 
 	require.Equal(t, expected, packages)
 }
+
+func TestMessageFieldsAndSigners(t *testing.T) {
+	packages, err := Parse(context.Background(), nil, "testdata/signer")
+	require.NoError(t, err)
+
+	msg, err := packages[0].MessageByName("MsgCreatePost")
+	require.NoError(t, err)
+	require.Equal(t, []string{`creator`}, msg.Signers)
+	require.Equal(t, []Field{
+		{Name: "creator", Number: 1, Type: "string"},
+		{Name: "title", Number: 2, Type: "string"},
+		{Name: "text", Number: 3, Type: "string"},
+		{Name: "data", Number: 4, Type: "bytes"},
+		{Name: "labels", Number: 5, Type: "map<string, string>"},
+	}, msg.Fields)
+}
@@ -36,6 +36,9 @@ type Chain struct {
 	// rpcAddress is the node address of tm.
 	rpcAddress string
 
+	// grpcAddress is the gRPC address of the chain's node.
+	grpcAddress string
+
 	// faucetAddress is the faucet address to get tokens for relayer accounts.
 	faucetAddress string
 
@@ -71,6 +74,14 @@ func WithFaucet(address string) Option {
 	}
 }
 
+// WithGRPCAddress provides the gRPC address of the chain's node, it's required to export
+// the chain to relayers that query chains with gRPC.
+func WithGRPCAddress(address string) Option {
+	return func(c *Chain) {
+		c.grpcAddress = address
+	}
+}
+
 // WithGasPrice gives the gas price to use to send ibc transactions to the chain.
 func WithGasPrice(gasPrice string) Option {
 	return func(c *Chain) {
@@ -207,26 +218,12 @@ func (c *Chain) Connect(dst *Chain, options ...ChannelOption) (id string, err er
 		apply(&channelOptions)
 	}
 
-	conf, err := relayerconfig.Get()
+	conf, err := relayerconfig.GetAt(c.r.configPath)
 	if err != nil {
 		return "", err
 	}
 
-	// determine a unique path name from chain ids with incremental numbers. e.g.:
-	// - src-dst
-	// - src-dst-2
-	pathID := fmt.Sprintf("%s-%s", c.ID, dst.ID)
-	var suffix string
-	i := 2
-	for {
-		guess := pathID + suffix
-		if _, err := conf.PathByID(guess); err != nil { // guess is unique.
-			pathID = guess
-			break
-		}
-		suffix = fmt.Sprintf("-%d", i)
-		i++
-	}
+	pathID := uniquePathID(conf, fmt.Sprintf("%s-%s", c.ID, dst.ID))
 
 	confPath := relayerconfig.Path{
 		ID:       pathID,
@@ -245,7 +242,7 @@ func (c *Chain) Connect(dst *Chain, options ...ChannelOption) (id string, err er
 
 	conf.Paths = append(conf.Paths, confPath)
 
-	if err := relayerconfig.Save(conf); err != nil {
+	if err := relayerconfig.SaveAt(c.r.configPath, conf); err != nil {
 		return "", err
 	}
 
@@ -269,12 +266,13 @@ func (c *Chain) ensureChainSetup(ctx context.Context) error {
 		Account:       c.accountName,
 		AddressPrefix: c.addressPrefix,
 		RPCAddress:    c.rpcAddress,
+		GRPCAddress:   c.grpcAddress,
 		GasPrice:      c.gasPrice,
 		GasLimit:      c.gasLimit,
 		ClientID:      c.clientID,
 	}
 
-	conf, err := relayerconfig.Get()
+	conf, err := relayerconfig.GetAt(c.r.configPath)
 	if err != nil {
 		return err
 	}
@@ -300,5 +298,5 @@ func (c *Chain) ensureChainSetup(ctx context.Context) error {
 		conf.Chains = append(conf.Chains, confChain)
 	}
 
-	return relayerconfig.Save(conf)
+	return relayerconfig.SaveAt(c.r.configPath, conf)
 }
@@ -56,6 +56,7 @@ type Chain struct {
 	Account       string `json:"account" yaml:"account"`
 	AddressPrefix string `json:"address_prefix" yaml:"address_prefix"`
 	RPCAddress    string `json:"rpc_address" yaml:"rpc_address"`
+	GRPCAddress   string `json:"grpc_address" yaml:"grpc_address,omitempty"`
 	GasPrice      string `json:"gas_price" yaml:"gas_price,omitempty"`
 	GasLimit      int64  `json:"gas_limit" yaml:"gas_limit,omitempty"`
 	ClientID      string `json:"client_id" yaml:"client_id,omitempty"`
@@ -70,6 +71,7 @@ type Path struct {
 
 type PathEnd struct {
 	ChainID      string `json:"chain_id" yaml:"chain_id"`
+	ClientID     string `json:"client_id" yaml:"client_id,omitempty"`
 	ConnectionID string `json:"connection_id" yaml:"connection_id,omitempty"`
 	ChannelID    string `json:"channel_id" yaml:"channel_id,omitempty"`
 	PortID       string `json:"port_id" yaml:"port_id"`
@@ -78,20 +80,35 @@ type PathEnd struct {
 	AckHeight    int64  `json:"ack_height" yaml:"ack_height,omitempty"`
 }
 
+// DefaultPath returns the path of the default relayer config.
+func DefaultPath() string {
+	return configPath
+}
+
 func Get() (Config, error) {
+	return GetAt(configPath)
+}
+
+// GetAt returns the relayer config at path.
+func GetAt(path string) (Config, error) {
 	c := Config{}
-	if err := confile.New(confile.DefaultYAMLEncodingCreator, configPath).Load(&c); err != nil {
+	if err := confile.New(confile.DefaultYAMLEncodingCreator, path).Load(&c); err != nil {
 		return c, err
 	}
 	if !reflect.DeepEqual(c, Config{}) && c.Version != supportVersion {
-		return c, fmt.Errorf("your relayer setup is outdated. run 'rm %s' and configure relayer again", configPath)
+		return c, fmt.Errorf("your relayer setup is outdated. run 'rm %s' and configure relayer again", path)
 	}
 	return c, nil
 }
 
 func Save(c Config) error {
+	return SaveAt(configPath, c)
+}
+
+// SaveAt saves c as the relayer config at path.
+func SaveAt(path string, c Config) error {
 	c.Version = supportVersion
-	return confile.New(confile.DefaultYAMLEncodingCreator, configPath).Save(c)
+	return confile.New(confile.DefaultYAMLEncodingCreator, path).Save(c)
 }
 
 func Delete() error {
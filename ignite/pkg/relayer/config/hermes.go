@@ -0,0 +1,214 @@
+package relayerconf
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const hermesPolicyAllow = "allow"
+
+// HermesConfig is the config of the Hermes relayer.
+type HermesConfig struct {
+	Global HermesGlobal  `toml:"global"`
+	Mode   HermesMode    `toml:"mode"`
+	Chains []HermesChain `toml:"chains"`
+}
+
+// HermesGlobal is the global config of Hermes.
+type HermesGlobal struct {
+	LogLevel string `toml:"log_level"`
+}
+
+// HermesMode configures the workers of Hermes.
+type HermesMode struct {
+	Clients     HermesClientsMode `toml:"clients"`
+	Connections HermesToggleMode  `toml:"connections"`
+	Channels    HermesToggleMode  `toml:"channels"`
+	Packets     HermesPacketsMode `toml:"packets"`
+}
+
+// HermesClientsMode configures the client workers of Hermes.
+type HermesClientsMode struct {
+	Enabled      bool `toml:"enabled"`
+	Refresh      bool `toml:"refresh"`
+	Misbehaviour bool `toml:"misbehaviour"`
+}
+
+// HermesToggleMode enables a worker of Hermes.
+type HermesToggleMode struct {
+	Enabled bool `toml:"enabled"`
+}
+
+// HermesPacketsMode configures the packet workers of Hermes.
+type HermesPacketsMode struct {
+	Enabled        bool  `toml:"enabled"`
+	ClearInterval  int64 `toml:"clear_interval"`
+	ClearOnStart   bool  `toml:"clear_on_start"`
+	TxConfirmation bool  `toml:"tx_confirmation"`
+}
+
+// HermesChain is a chain in the Hermes config.
+type HermesChain struct {
+	ID             string               `toml:"id"`
+	RPCAddr        string               `toml:"rpc_addr"`
+	GRPCAddr       string               `toml:"grpc_addr"`
+	WebsocketAddr  string               `toml:"websocket_addr"`
+	RPCTimeout     string               `toml:"rpc_timeout"`
+	AccountPrefix  string               `toml:"account_prefix"`
+	KeyName        string               `toml:"key_name"`
+	StorePrefix    string               `toml:"store_prefix"`
+	MaxGas         int64                `toml:"max_gas,omitempty"`
+	GasPrice       HermesGasPrice       `toml:"gas_price"`
+	ClockDrift     string               `toml:"clock_drift"`
+	TrustThreshold HermesTrustThreshold `toml:"trust_threshold"`
+	PacketFilter   HermesPacketFilter   `toml:"packet_filter"`
+}
+
+// HermesGasPrice is the gas price of a chain in the Hermes config.
+type HermesGasPrice struct {
+	Price float64 `toml:"price"`
+	Denom string  `toml:"denom"`
+}
+
+// HermesTrustThreshold is the trust threshold of the clients of a chain in the Hermes config.
+type HermesTrustThreshold struct {
+	Numerator   string `toml:"numerator"`
+	Denominator string `toml:"denominator"`
+}
+
+// HermesPacketFilter filters the channels that Hermes relays packets for.
+// each item of List is a port id and channel id pair.
+type HermesPacketFilter struct {
+	Policy string     `toml:"policy"`
+	List   [][]string `toml:"list"`
+}
+
+// ToHermes converts c to a Hermes config. paths are converted to the packet filters
+// of the chains, so Hermes only relays the channels of the paths.
+// accounts are referenced by their key names.
+func ToHermes(c Config) (HermesConfig, error) {
+	h := HermesConfig{
+		Global: HermesGlobal{LogLevel: "info"},
+		Mode: HermesMode{
+			Clients: HermesClientsMode{Enabled: true, Refresh: true, Misbehaviour: true},
+			Packets: HermesPacketsMode{Enabled: true, ClearInterval: 100, ClearOnStart: true, TxConfirmation: true},
+		},
+	}
+
+	for _, chain := range c.Chains {
+		rpc, err := url.Parse(chain.RPCAddress)
+		if err != nil {
+			return HermesConfig{}, err
+		}
+
+		gasPrice, err := sdk.ParseDecCoin(chain.GasPrice)
+		if err != nil {
+			return HermesConfig{}, fmt.Errorf("invalid gas price of chain %q: %w", chain.ID, err)
+		}
+
+		price, err := strconv.ParseFloat(gasPrice.Amount.String(), 64)
+		if err != nil {
+			return HermesConfig{}, err
+		}
+
+		// the gRPC port of a chain can't be guessed from its RPC address.
+		if chain.GRPCAddress == "" {
+			return HermesConfig{}, fmt.Errorf(
+				"the gRPC address of chain %q is unknown, configure the chain with its gRPC address",
+				chain.ID,
+			)
+		}
+		grpcAddress := chain.GRPCAddress
+		if !strings.Contains(grpcAddress, "://") {
+			grpcAddress = "http://" + grpcAddress
+		}
+
+		hc := HermesChain{
+			ID:            chain.ID,
+			RPCAddr:       chain.RPCAddress,
+			GRPCAddr:      grpcAddress,
+			WebsocketAddr: websocketAddress(*rpc),
+			RPCTimeout:    "10s",
+			AccountPrefix: chain.AddressPrefix,
+			KeyName:       chain.Account,
+			StorePrefix:   "ibc",
+			MaxGas:        chain.GasLimit,
+			GasPrice:      HermesGasPrice{Price: price, Denom: gasPrice.Denom},
+			ClockDrift:    "5s",
+			TrustThreshold: HermesTrustThreshold{
+				Numerator:   "1",
+				Denominator: "3",
+			},
+			PacketFilter: HermesPacketFilter{
+				Policy: hermesPolicyAllow,
+				List:   [][]string{},
+			},
+		}
+
+		for _, path := range c.Paths {
+			for _, end := range []PathEnd{path.Src, path.Dst} {
+				if end.ChainID == chain.ID && end.ChannelID != "" {
+					hc.PacketFilter.List = append(hc.PacketFilter.List, []string{end.PortID, end.ChannelID})
+				}
+			}
+		}
+
+		h.Chains = append(h.Chains, hc)
+	}
+
+	return h, nil
+}
+
+// FromHermes converts the chains of h to a config. Hermes doesn't have paths, so a path
+// is created for each channel allowed by the packet filters with only its source end.
+// the counterparty ends of the paths must be completed from the states of the channels.
+func FromHermes(h HermesConfig) Config {
+	var c Config
+
+	for _, hc := range h.Chains {
+		c.Chains = append(c.Chains, Chain{
+			ID:            hc.ID,
+			Account:       hc.KeyName,
+			AddressPrefix: hc.AccountPrefix,
+			RPCAddress:    hc.RPCAddr,
+			GRPCAddress:   hc.GRPCAddr,
+			GasPrice:      strconv.FormatFloat(hc.GasPrice.Price, 'f', -1, 64) + hc.GasPrice.Denom,
+			GasLimit:      hc.MaxGas,
+		})
+
+		if hc.PacketFilter.Policy != hermesPolicyAllow {
+			continue
+		}
+
+		for _, item := range hc.PacketFilter.List {
+			if len(item) != 2 {
+				continue
+			}
+
+			c.Paths = append(c.Paths, Path{
+				Src: PathEnd{
+					ChainID:   hc.ID,
+					PortID:    item[0],
+					ChannelID: item[1],
+				},
+			})
+		}
+	}
+
+	return c
+}
+
+// websocketAddress returns the websocket address of the Tendermint RPC at rpc.
+func websocketAddress(rpc url.URL) string {
+	if rpc.Scheme == "https" {
+		rpc.Scheme = "wss"
+	} else {
+		rpc.Scheme = "ws"
+	}
+	rpc.Path = "/websocket"
+	return rpc.String()
+}
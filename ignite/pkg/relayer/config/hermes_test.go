@@ -0,0 +1,81 @@
+package relayerconf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	Chains: []Chain{
+		{
+			ID:            "mars",
+			Account:       "mars-relayer",
+			AddressPrefix: "cosmos",
+			RPCAddress:    "https://rpc.mars.com:443",
+			GRPCAddress:   "https://grpc.mars.com:443",
+			GasPrice:      "0.025stake",
+		},
+		{
+			ID:            "venus",
+			Account:       "venus-relayer",
+			AddressPrefix: "cosmos",
+			RPCAddress:    "http://localhost:26659",
+			GRPCAddress:   "http://localhost:9092",
+			GasPrice:      "0.1token",
+		},
+	},
+	Paths: []Path{
+		{
+			ID: "mars-venus",
+			Src: PathEnd{
+				ChainID:      "mars",
+				ClientID:     "07-tendermint-0",
+				ConnectionID: "connection-0",
+				ChannelID:    "channel-0",
+				PortID:       "transfer",
+			},
+			Dst: PathEnd{
+				ChainID:      "venus",
+				ClientID:     "07-tendermint-1",
+				ConnectionID: "connection-1",
+				ChannelID:    "channel-2",
+				PortID:       "transfer",
+			},
+		},
+	},
+}
+
+func TestHermes(t *testing.T) {
+	hermes, err := ToHermes(testConfig)
+	require.NoError(t, err)
+	require.Len(t, hermes.Chains, 2)
+
+	mars := hermes.Chains[0]
+	require.Equal(t, "mars-relayer", mars.KeyName)
+	require.Equal(t, "https://grpc.mars.com:443", mars.GRPCAddr)
+	require.Equal(t, "wss://rpc.mars.com:443/websocket", mars.WebsocketAddr)
+	require.Equal(t, HermesGasPrice{Price: 0.025, Denom: "stake"}, mars.GasPrice)
+	require.Equal(t, [][]string{{"transfer", "channel-0"}}, mars.PacketFilter.List)
+	require.Equal(t, [][]string{{"transfer", "channel-2"}}, hermes.Chains[1].PacketFilter.List)
+
+	conf := FromHermes(hermes)
+	require.Equal(t, testConfig.Chains, conf.Chains)
+	require.Equal(t, []Path{
+		{Src: PathEnd{ChainID: "mars", PortID: "transfer", ChannelID: "channel-0"}},
+		{Src: PathEnd{ChainID: "venus", PortID: "transfer", ChannelID: "channel-2"}},
+	}, conf.Paths)
+}
+
+func TestHermesGRPCAddress(t *testing.T) {
+	conf := Config{Chains: []Chain{{ID: "mars", RPCAddress: "http://localhost:26657", GasPrice: "0stake"}}}
+
+	// the gRPC port isn't guessed when the gRPC address is unknown.
+	_, err := ToHermes(conf)
+	require.EqualError(t, err, `the gRPC address of chain "mars" is unknown, configure the chain with its gRPC address`)
+
+	conf.Chains[0].GRPCAddress = "localhost:9092"
+	hermes, err := ToHermes(conf)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:9092", hermes.Chains[0].GRPCAddr)
+}
@@ -0,0 +1,175 @@
+package relayerconf
+
+import (
+	"sort"
+)
+
+const (
+	rlyChainType       = "cosmos"
+	rlyFilterAllowlist = "allowlist"
+)
+
+// RlyConfig is the config of the Go relayer (rly).
+type RlyConfig struct {
+	Global RlyGlobal           `yaml:"global"`
+	Chains map[string]RlyChain `yaml:"chains"`
+	Paths  map[string]RlyPath  `yaml:"paths"`
+}
+
+// RlyGlobal is the global config of rly.
+type RlyGlobal struct {
+	APIListenAddr  string `yaml:"api-listen-addr"`
+	Timeout        string `yaml:"timeout"`
+	Memo           string `yaml:"memo"`
+	LightCacheSize int    `yaml:"light-cache-size"`
+}
+
+// RlyChain is a chain in the rly config.
+type RlyChain struct {
+	Type  string        `yaml:"type"`
+	Value RlyChainValue `yaml:"value"`
+}
+
+// RlyChainValue is the config of a Cosmos chain in the rly config.
+type RlyChainValue struct {
+	Key            string  `yaml:"key"`
+	ChainID        string  `yaml:"chain-id"`
+	RPCAddr        string  `yaml:"rpc-addr"`
+	AccountPrefix  string  `yaml:"account-prefix"`
+	KeyringBackend string  `yaml:"keyring-backend"`
+	GasAdjustment  float64 `yaml:"gas-adjustment"`
+	GasPrices      string  `yaml:"gas-prices"`
+	Debug          bool    `yaml:"debug"`
+	Timeout        string  `yaml:"timeout"`
+	OutputFormat   string  `yaml:"output-format"`
+	SignMode       string  `yaml:"sign-mode"`
+}
+
+// RlyPath is a path in the rly config.
+type RlyPath struct {
+	Src              RlyPathEnd       `yaml:"src"`
+	Dst              RlyPathEnd       `yaml:"dst"`
+	SrcChannelFilter RlyChannelFilter `yaml:"src-channel-filter"`
+}
+
+// RlyPathEnd is an end of a path in the rly config.
+type RlyPathEnd struct {
+	ChainID      string `yaml:"chain-id"`
+	ClientID     string `yaml:"client-id"`
+	ConnectionID string `yaml:"connection-id"`
+}
+
+// RlyChannelFilter filters the channels of a path that rly relays packets for.
+type RlyChannelFilter struct {
+	Rule        string   `yaml:"rule"`
+	ChannelList []string `yaml:"channel-list"`
+}
+
+// ToRly converts c to an rly config. accounts are referenced by their key names
+// in the keyring with keyringBackend.
+func ToRly(c Config, keyringBackend string) RlyConfig {
+	r := RlyConfig{
+		Global: RlyGlobal{
+			APIListenAddr:  ":5183",
+			Timeout:        "10s",
+			LightCacheSize: 20,
+		},
+		Chains: make(map[string]RlyChain),
+		Paths:  make(map[string]RlyPath),
+	}
+
+	for _, chain := range c.Chains {
+		r.Chains[chain.ID] = RlyChain{
+			Type: rlyChainType,
+			Value: RlyChainValue{
+				Key:            chain.Account,
+				ChainID:        chain.ID,
+				RPCAddr:        chain.RPCAddress,
+				AccountPrefix:  chain.AddressPrefix,
+				KeyringBackend: keyringBackend,
+				GasAdjustment:  1.5,
+				GasPrices:      chain.GasPrice,
+				Timeout:        "10s",
+				OutputFormat:   "json",
+				SignMode:       "direct",
+			},
+		}
+	}
+
+	for _, path := range c.Paths {
+		rp := RlyPath{
+			Src: RlyPathEnd{
+				ChainID:      path.Src.ChainID,
+				ClientID:     path.Src.ClientID,
+				ConnectionID: path.Src.ConnectionID,
+			},
+			Dst: RlyPathEnd{
+				ChainID:      path.Dst.ChainID,
+				ClientID:     path.Dst.ClientID,
+				ConnectionID: path.Dst.ConnectionID,
+			},
+		}
+
+		if path.Src.ChannelID != "" {
+			rp.SrcChannelFilter = RlyChannelFilter{
+				Rule:        rlyFilterAllowlist,
+				ChannelList: []string{path.Src.ChannelID},
+			}
+		}
+
+		r.Paths[path.ID] = rp
+	}
+
+	return r
+}
+
+// FromRly converts r to a config. rly paths are connections, so a path is created
+// for each channel allowed by the channel filter of a path, or a single path without
+// channels when there is no filter. the ports and the counterparty channels of the
+// paths must be completed from the states of the channels.
+func FromRly(r RlyConfig) Config {
+	var c Config
+
+	for _, chain := range r.Chains {
+		c.Chains = append(c.Chains, Chain{
+			ID:            chain.Value.ChainID,
+			Account:       chain.Value.Key,
+			AddressPrefix: chain.Value.AccountPrefix,
+			RPCAddress:    chain.Value.RPCAddr,
+			GasPrice:      chain.Value.GasPrices,
+		})
+	}
+
+	for id, rp := range r.Paths {
+		path := Path{
+			ID: id,
+			Src: PathEnd{
+				ChainID:      rp.Src.ChainID,
+				ClientID:     rp.Src.ClientID,
+				ConnectionID: rp.Src.ConnectionID,
+			},
+			Dst: PathEnd{
+				ChainID:      rp.Dst.ChainID,
+				ClientID:     rp.Dst.ClientID,
+				ConnectionID: rp.Dst.ConnectionID,
+			},
+		}
+
+		if rp.SrcChannelFilter.Rule != rlyFilterAllowlist || len(rp.SrcChannelFilter.ChannelList) == 0 {
+			c.Paths = append(c.Paths, path)
+			continue
+		}
+
+		for _, channelID := range rp.SrcChannelFilter.ChannelList {
+			p := path
+			p.Src.ChannelID = channelID
+			c.Paths = append(c.Paths, p)
+		}
+	}
+
+	// maps have no order, keep the result stable.
+	sort.Slice(c.Chains, func(i, j int) bool { return c.Chains[i].ID < c.Chains[j].ID })
+	sort.SliceStable(c.Paths, func(i, j int) bool { return c.Paths[i].ID < c.Paths[j].ID })
+
+	return c
+}
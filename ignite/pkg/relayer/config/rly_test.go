@@ -0,0 +1,39 @@
+package relayerconf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRly(t *testing.T) {
+	rly := ToRly(testConfig, "test")
+	require.Len(t, rly.Chains, 2)
+	require.Equal(t, "mars-relayer", rly.Chains["mars"].Value.Key)
+	require.Equal(t, "test", rly.Chains["mars"].Value.KeyringBackend)
+	require.Equal(t, RlyPath{
+		Src: RlyPathEnd{ChainID: "mars", ClientID: "07-tendermint-0", ConnectionID: "connection-0"},
+		Dst: RlyPathEnd{ChainID: "venus", ClientID: "07-tendermint-1", ConnectionID: "connection-1"},
+		SrcChannelFilter: RlyChannelFilter{
+			Rule:        rlyFilterAllowlist,
+			ChannelList: []string{"channel-0"},
+		},
+	}, rly.Paths["mars-venus"])
+
+	// rly doesn't use the gRPC addresses of the chains.
+	var chains []Chain
+	for _, c := range testConfig.Chains {
+		c.GRPCAddress = ""
+		chains = append(chains, c)
+	}
+
+	conf := FromRly(rly)
+	require.Equal(t, chains, conf.Chains)
+
+	// ports and the counterparty channels are completed from the chains.
+	path := testConfig.Paths[0]
+	path.Src.PortID = ""
+	path.Dst.PortID = ""
+	path.Dst.ChannelID = ""
+	require.Equal(t, []Path{path}, conf.Paths)
+}
@@ -0,0 +1,213 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibccoretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// heightPollInterval is the interval to check chains for new blocks.
+	heightPollInterval = time.Second
+
+	// maxRetryInterval is the max interval to retry the failed relays of a path.
+	maxRetryInterval = time.Minute
+
+	// validatorsPerPage is the number of validators queried at once.
+	validatorsPerPage = 100
+)
+
+// endpoint is a chain that the relayer queries and sends IBC transactions to.
+type endpoint struct {
+	conf     relayerconf.Chain
+	client   cosmosclient.Client
+	signer   string
+	revision uint64
+}
+
+// newEndpoint connects to the chain with chainID from conf and signs the transactions
+// with the relayer account of the chain.
+func (r Relayer) newEndpoint(ctx context.Context, conf relayerconf.Config, chainID string) (*endpoint, error) {
+	chain, err := conf.ChainByID(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := dialEndpoint(ctx, chain, cosmosclient.WithAccountRegistry(r.ca))
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := r.ca.GetByName(chain.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	e.signer = account.Address(chain.AddressPrefix)
+
+	return e, nil
+}
+
+// dialEndpoint connects to chain to query it. the endpoint cannot send transactions
+// until it has a signer.
+func dialEndpoint(ctx context.Context, chain relayerconf.Chain, options ...cosmosclient.Option) (*endpoint, error) {
+	options = append([]cosmosclient.Option{
+		cosmosclient.WithNodeAddress(fixRPCAddress(chain.RPCAddress)),
+		cosmosclient.WithAddressPrefix(chain.AddressPrefix),
+	}, options...)
+
+	client, err := cosmosclient.New(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// IBC types are required to decode the query responses.
+	ibccoretypes.RegisterInterfaces(client.Context().InterfaceRegistry)
+
+	if chain.GasPrice != "" {
+		client.Factory = client.Factory.WithGasPrices(chain.GasPrice)
+	}
+
+	return &endpoint{
+		conf:     chain,
+		client:   client,
+		revision: clienttypes.ParseChainID(chain.ID),
+	}, nil
+}
+
+// height returns the IBC height of the block at height.
+func (e *endpoint) height(height int64) clienttypes.Height {
+	return clienttypes.NewHeight(e.revision, uint64(height))
+}
+
+// latestHeight returns the height of the latest block.
+func (e *endpoint) latestHeight(ctx context.Context) (int64, error) {
+	status, err := e.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// waitForHeight waits until the chain produces the block at height.
+func (e *endpoint) waitForHeight(ctx context.Context, height int64) error {
+	ticker := time.NewTicker(heightPollInterval)
+	defer ticker.Stop()
+
+	for {
+		latest, err := e.latestHeight(ctx)
+		if err != nil {
+			return err
+		}
+		if latest >= height {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// send broadcasts msgs in a single tx and waits for the next block, so the state
+// changes of the tx can be proved to the counterparty right away.
+func (e *endpoint) send(ctx context.Context, msgs ...sdk.Msg) (cosmosclient.Response, error) {
+	res, err := e.client.BroadcastTx(e.conf.Account, msgs...)
+	if err != nil {
+		return cosmosclient.Response{}, fmt.Errorf("%s: %w", e.conf.ID, err)
+	}
+
+	return res, e.waitForHeight(ctx, res.Height+1)
+}
+
+// signedHeader returns the header and the commit of the block at height.
+func (e *endpoint) signedHeader(ctx context.Context, height int64) (*tmtypes.SignedHeader, error) {
+	res, err := e.client.RPC.Commit(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	return &res.SignedHeader, nil
+}
+
+// validatorSet returns the validator set of the block at height.
+func (e *endpoint) validatorSet(ctx context.Context, height int64) (*tmproto.ValidatorSet, error) {
+	var (
+		validators []*tmtypes.Validator
+		perPage    = validatorsPerPage
+	)
+
+	for page := 1; ; page++ {
+		res, err := e.client.RPC.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+
+		if len(validators) >= res.Total {
+			break
+		}
+	}
+
+	valset := tmtypes.NewValidatorSet(validators)
+
+	// computes the total voting power that is required by ToProto.
+	valset.TotalVotingPower()
+
+	return valset.ToProto()
+}
+
+// unbondingPeriod returns the unbonding period of the chain's validators.
+func (e *endpoint) unbondingPeriod(ctx context.Context) (time.Duration, error) {
+	res, err := stakingtypes.NewQueryClient(e.client.Context()).Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Params.UnbondingTime, nil
+}
+
+// queryProof returns the value of the IBC store key and its proof at the state of
+// the block at height. the proof can be verified with the consensus state of the
+// returned proof height.
+func (e *endpoint) queryProof(ctx context.Context, key []byte, height int64) (
+	value, proof []byte, proofHeight clienttypes.Height, err error) {
+	res, err := e.client.RPC.ABCIQueryWithOptions(
+		ctx,
+		fmt.Sprintf("store/%s/key", host.StoreKey),
+		key,
+		rpcclient.ABCIQueryOptions{Height: provedStateHeight(height), Prove: true},
+	)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+	if !res.Response.IsOK() {
+		return nil, nil, clienttypes.Height{}, fmt.Errorf("%s: cannot query proof: %s", e.conf.ID, res.Response.Log)
+	}
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.Response.ProofOps)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+
+	proof, err = e.client.Context().Codec.Marshal(&merkleProof)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+
+	return res.Response.Value, proof, e.height(provingHeight(res.Response.Height)), nil
+}
@@ -0,0 +1,304 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/goccy/go-yaml"
+	"github.com/imdario/mergo"
+	"github.com/pelletier/go-toml"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// Format is the config format of another relayer.
+type Format string
+
+const (
+	// FormatHermes is the config format of the Hermes relayer.
+	FormatHermes Format = "hermes"
+
+	// FormatRly is the config format of the Go relayer.
+	FormatRly Format = "rly"
+)
+
+// Formats are the supported config formats.
+var Formats = []Format{FormatHermes, FormatRly}
+
+// ParseFormat parses a config format from its name.
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown relayer config format %q, supported formats are %v", name, Formats)
+}
+
+// Export converts the relayer config to a config of another relayer in format.
+// accounts are referenced by their names in the keyring with keyringBackend and
+// keys are never exported.
+func (r Relayer) Export(ctx context.Context, format Format, keyringBackend string) ([]byte, error) {
+	conf, err := relayerconf.GetAt(r.configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// clients of the paths linked before client ids were saved are read from their connections.
+	for i, path := range conf.Paths {
+		for _, end := range []*relayerconf.PathEnd{&conf.Paths[i].Src, &conf.Paths[i].Dst} {
+			if end.ConnectionID == "" || end.ClientID != "" {
+				continue
+			}
+
+			chain, err := conf.ChainByID(end.ChainID)
+			if err != nil {
+				return nil, err
+			}
+
+			e, err := dialEndpoint(ctx, chain)
+			if err != nil {
+				return nil, err
+			}
+
+			connection, err := queryConnection(ctx, e, end.ConnectionID)
+			if err != nil {
+				return nil, fmt.Errorf("path %q: %w", path.ID, err)
+			}
+
+			end.ClientID = connection.ClientId
+		}
+	}
+
+	switch format {
+	case FormatHermes:
+		hermes, err := relayerconf.ToHermes(conf)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := toml.NewEncoder(&buf).Encode(hermes); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+
+	case FormatRly:
+		return yaml.Marshal(relayerconf.ToRly(conf, keyringBackend))
+
+	default:
+		return nil, fmt.Errorf("unknown relayer config format %q", format)
+	}
+}
+
+// Import merges the chains and the paths of the config of another relayer in format
+// into the relayer config. the ends of the imported paths are completed from the
+// states of their channels and connections on the chains, paths that are already
+// configured are skipped. it returns the ids of the imported paths.
+func (r Relayer) Import(ctx context.Context, format Format, data []byte) (pathIDs []string, err error) {
+	var imported relayerconf.Config
+
+	switch format {
+	case FormatHermes:
+		var hermes relayerconf.HermesConfig
+		if err := toml.Unmarshal(data, &hermes); err != nil {
+			return nil, err
+		}
+		imported = relayerconf.FromHermes(hermes)
+
+	case FormatRly:
+		var rly relayerconf.RlyConfig
+		if err := yaml.Unmarshal(data, &rly); err != nil {
+			return nil, err
+		}
+		imported = relayerconf.FromRly(rly)
+
+	default:
+		return nil, fmt.Errorf("unknown relayer config format %q", format)
+	}
+
+	conf, err := relayerconf.GetAt(r.configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, chain := range imported.Chains {
+		if err := mergeChain(&conf, chain); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, path := range imported.Paths {
+		if path, err = completePath(ctx, imported, path); err != nil {
+			return nil, err
+		}
+
+		if hasPath(conf, path) {
+			continue
+		}
+
+		if path.ID == "" {
+			path.ID = fmt.Sprintf("%s-%s", path.Src.ChainID, path.Dst.ChainID)
+		}
+		path.ID = uniquePathID(conf, path.ID)
+
+		conf.Paths = append(conf.Paths, path)
+		pathIDs = append(pathIDs, path.ID)
+	}
+
+	return pathIDs, relayerconf.SaveAt(r.configPath, conf)
+}
+
+// completePath fills the missing ids, ports and the counterparty end of path from
+// the states of its channel and connection on its source chain.
+func completePath(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (relayerconf.Path, error) {
+	srcChain, err := conf.ChainByID(path.Src.ChainID)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	src, err := dialEndpoint(ctx, srcChain)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	if path.Src.ChannelID != "" {
+		channel, err := queryChannel(ctx, src, path.Src)
+		if err != nil {
+			return relayerconf.Path{}, err
+		}
+
+		path.Ordering = channel.Ordering.String()
+		path.Src.PortID = channel.PortId
+		path.Src.Version = channel.Version
+		path.Src.ConnectionID = channel.ConnectionHops[0]
+		path.Dst.PortID = channel.Counterparty.PortId
+		path.Dst.ChannelID = channel.Counterparty.ChannelId
+		path.Dst.Version = channel.Version
+	} else {
+		// a new transfer channel is opened on the connection when the path is linked.
+		path.Ordering = OrderingUnordered
+		path.Src.PortID, path.Src.Version = TransferPort, TransferVersion
+		path.Dst.PortID, path.Dst.Version = TransferPort, TransferVersion
+	}
+
+	if path.Src.ConnectionID == "" {
+		return relayerconf.Path{}, fmt.Errorf("%s: path has no connection", path.Src.ChainID)
+	}
+
+	connection, err := queryConnection(ctx, src, path.Src.ConnectionID)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	path.Src.ClientID = connection.ClientId
+	path.Dst.ClientID = connection.Counterparty.ClientId
+	path.Dst.ConnectionID = connection.Counterparty.ConnectionId
+
+	client, err := clientState(ctx, src, path.Src.ClientID)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	if path.Dst.ChainID != "" && path.Dst.ChainID != client.ChainId {
+		return relayerconf.Path{}, fmt.Errorf("%s: client %s tracks chain %q instead of %q",
+			path.Src.ChainID, path.Src.ClientID, client.ChainId, path.Dst.ChainID)
+	}
+	path.Dst.ChainID = client.ChainId
+
+	if _, err := conf.ChainByID(path.Dst.ChainID); err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	return path, nil
+}
+
+// queryChannel returns the channel of end.
+func queryChannel(ctx context.Context, e *endpoint, end relayerconf.PathEnd) (*channeltypes.IdentifiedChannel, error) {
+	// the port of a channel is unknown when only the channel id is configured.
+	if end.PortID != "" {
+		res, err := channeltypes.NewQueryClient(e.client.Context()).Channel(
+			ctx,
+			&channeltypes.QueryChannelRequest{PortId: end.PortID, ChannelId: end.ChannelID},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		channel := channeltypes.NewIdentifiedChannel(end.PortID, end.ChannelID, *res.Channel)
+		return &channel, nil
+	}
+
+	res, err := channeltypes.NewQueryClient(e.client.Context()).ConnectionChannels(
+		ctx,
+		&channeltypes.QueryConnectionChannelsRequest{Connection: end.ConnectionID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range res.Channels {
+		if channel.ChannelId == end.ChannelID {
+			return channel, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: channel %s cannot be found on connection %s", e.conf.ID, end.ChannelID, end.ConnectionID)
+}
+
+// queryConnection returns the connection with id.
+func queryConnection(ctx context.Context, e *endpoint, id string) (*connectiontypes.ConnectionEnd, error) {
+	res, err := connectiontypes.NewQueryClient(e.client.Context()).Connection(
+		ctx,
+		&connectiontypes.QueryConnectionRequest{ConnectionId: id},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Connection, nil
+}
+
+// mergeChain adds chain to conf or updates the chain with the same id.
+func mergeChain(conf *relayerconf.Config, chain relayerconf.Chain) error {
+	for i, c := range conf.Chains {
+		if c.ID == chain.ID {
+			return mergo.Merge(&conf.Chains[i], chain, mergo.WithOverride)
+		}
+	}
+
+	conf.Chains = append(conf.Chains, chain)
+	return nil
+}
+
+// hasPath checks if conf already has a path for the channel or, if there is no channel,
+// the connection of path in either direction.
+func hasPath(conf relayerconf.Config, path relayerconf.Path) bool {
+	same := func(a, b relayerconf.PathEnd) bool {
+		if a.ChainID != b.ChainID || a.ConnectionID != b.ConnectionID {
+			return false
+		}
+		return a.ChannelID == b.ChannelID && a.PortID == b.PortID
+	}
+
+	for _, p := range conf.Paths {
+		if (same(p.Src, path.Src) && same(p.Dst, path.Dst)) || (same(p.Src, path.Dst) && same(p.Dst, path.Src)) {
+			return true
+		}
+	}
+	return false
+}
+
+// uniquePathID determines a unique path id from id with incremental numbers. e.g.:
+// - src-dst
+// - src-dst-2
+func uniquePathID(conf relayerconf.Config, id string) string {
+	guess := id
+	for i := 2; ; i++ {
+		if _, err := conf.PathByID(guess); err != nil { // guess is unique.
+			return guess
+		}
+		guess = fmt.Sprintf("%s-%d", id, i)
+	}
+}
@@ -0,0 +1,239 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+)
+
+const (
+	// maxClockDrift is the max time that the clocks of the chains can differ.
+	maxClockDrift = 10 * time.Minute
+
+	// trustingPeriodRatio is the ratio of the unbonding period that a client
+	// trusts a validator set for.
+	trustingPeriodRatio = 2.0 / 3.0
+)
+
+// clientUpgradePath is the store path of the upgraded client states.
+var clientUpgradePath = []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState}
+
+// createClient creates a light client of src on dst and returns its id.
+func createClient(ctx context.Context, src, dst *endpoint) (clientID string, err error) {
+	height, err := src.latestHeight(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := src.signedHeader(ctx, height)
+	if err != nil {
+		return "", err
+	}
+
+	unbondingPeriod, err := src.unbondingPeriod(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	clientState := ibctmtypes.NewClientState(
+		src.conf.ID,
+		ibctmtypes.DefaultTrustLevel,
+		time.Duration(float64(unbondingPeriod)*trustingPeriodRatio),
+		unbondingPeriod,
+		maxClockDrift,
+		src.height(height),
+		commitmenttypes.GetSDKSpecs(),
+		clientUpgradePath,
+		false,
+		false,
+	)
+
+	consensusState := ibctmtypes.NewConsensusState(
+		header.Time,
+		commitmenttypes.NewMerkleRoot(header.AppHash),
+		header.NextValidatorsHash,
+	)
+
+	msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, dst.signer)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := dst.send(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+
+	return eventAttribute(res, clienttypes.EventTypeCreateClient, clienttypes.AttributeKeyClientID)
+}
+
+// clientState returns the state of the client with clientID on e.
+func clientState(ctx context.Context, e *endpoint, clientID string) (*ibctmtypes.ClientState, error) {
+	res, err := clienttypes.NewQueryClient(e.client.Context()).ClientState(ctx, &clienttypes.QueryClientStateRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := clienttypes.UnpackClientState(res.ClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	tmState, ok := state.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, errors.New("only Tendermint clients are supported")
+	}
+
+	return tmState, nil
+}
+
+// consensusState returns the consensus state of the client with clientID on e at height.
+func consensusState(ctx context.Context, e *endpoint, clientID string, height exported.Height) (
+	*ibctmtypes.ConsensusState, error) {
+	res, err := clienttypes.NewQueryClient(e.client.Context()).ConsensusState(ctx, &clienttypes.QueryConsensusStateRequest{
+		ClientId:       clientID,
+		RevisionNumber: height.GetRevisionNumber(),
+		RevisionHeight: height.GetRevisionHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := clienttypes.UnpackConsensusState(res.ConsensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	tmState, ok := state.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return nil, errors.New("only Tendermint clients are supported")
+	}
+
+	return tmState, nil
+}
+
+// updateClientMsg returns a msg that updates the client of src with clientID on dst
+// to height. a nil msg is returned when the client is already at height.
+func updateClientMsg(ctx context.Context, src, dst *endpoint, clientID string, height int64) (sdk.Msg, error) {
+	state, err := clientState(ctx, dst, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedHeight := state.LatestHeight
+	if trustedHeight.RevisionHeight >= uint64(height) {
+		return nil, nil
+	}
+
+	header, err := src.lightHeader(ctx, trustedHeight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return clienttypes.NewMsgUpdateClient(clientID, header, dst.signer)
+}
+
+// lightHeader returns the header of the block at height that is verified by a client
+// of e that trusts the block at trustedHeight.
+func (e *endpoint) lightHeader(ctx context.Context, trustedHeight clienttypes.Height, height int64) (
+	*ibctmtypes.Header, error) {
+	signedHeader, err := e.signedHeader(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSet, err := e.validatorSet(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the header is verified with the validators that were trusted for the block
+	// following the latest trusted one.
+	trustedValidators, err := e.validatorSet(ctx, int64(trustedHeight.RevisionHeight)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibctmtypes.Header{
+		SignedHeader:      signedHeader.ToProto(),
+		ValidatorSet:      validatorSet,
+		TrustedHeight:     trustedHeight,
+		TrustedValidators: trustedValidators,
+	}, nil
+}
+
+// withClientUpdate prepends the msg that updates the client of src with clientID
+// on dst to height to msgs.
+func withClientUpdate(ctx context.Context, src, dst *endpoint, clientID string, height int64, msgs ...sdk.Msg) (
+	[]sdk.Msg, error) {
+	update, err := updateClientMsg(ctx, src, dst, clientID, height)
+	if err != nil {
+		return nil, err
+	}
+	if update == nil {
+		return msgs, nil
+	}
+	return append([]sdk.Msg{update}, msgs...), nil
+}
+
+// refreshClient updates the client of src with clientID on dst when its latest consensus
+// state is older than a third of the trusting period, so the client doesn't expire
+// while there is no packet to relay.
+func refreshClient(ctx context.Context, src, dst *endpoint, clientID string) error {
+	state, err := clientState(ctx, dst, clientID)
+	if err != nil {
+		return err
+	}
+
+	consensus, err := consensusState(ctx, dst, clientID, state.LatestHeight)
+	if err != nil {
+		return err
+	}
+
+	if time.Since(consensus.Timestamp) < state.TrustingPeriod/3 {
+		return nil
+	}
+
+	height, err := src.latestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	msg, err := updateClientMsg(ctx, src, dst, clientID, height)
+	if err != nil || msg == nil {
+		return err
+	}
+
+	_, err = dst.send(ctx, msg)
+	return err
+}
+
+// eventAttribute returns the value of the first attribute with key of the first
+// event with eventType emitted by the tx of res.
+func eventAttribute(res cosmosclient.Response, eventType, key string) (string, error) {
+	for _, log := range res.Logs {
+		for _, event := range log.Events {
+			if event.Type != eventType {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == key {
+					return attr.Value, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("%s attribute of %s event not found", key, eventType)
+}
@@ -0,0 +1,384 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// ibcPrefix is the prefix of the keys in the IBC stores.
+var ibcPrefix = commitmenttypes.NewMerklePrefix([]byte(host.StoreKey))
+
+// pathEnd is an end of a path on its chain.
+type pathEnd struct {
+	*endpoint
+	relayerconf.PathEnd
+}
+
+// link is a path between two chains.
+type link struct {
+	path     relayerconf.Path
+	src, dst *pathEnd
+}
+
+// newLink connects to the chains of path.
+func (r Relayer) newLink(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (*link, error) {
+	src, err := r.newEndpoint(ctx, conf, path.Src.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	dst, err := r.newEndpoint(ctx, conf, path.Dst.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &link{
+		path: path,
+		src:  &pathEnd{endpoint: src, PathEnd: path.Src},
+		dst:  &pathEnd{endpoint: dst, PathEnd: path.Dst},
+	}, nil
+}
+
+// Path returns the path with the current state of its ends.
+func (l *link) Path() relayerconf.Path {
+	path := l.path
+	path.Src = l.src.PathEnd
+	path.Dst = l.dst.PathEnd
+	return path
+}
+
+// open creates the clients, the connection and the channel of the path.
+// clients configured for the chains are used instead of creating new ones and
+// the channel is opened on the connection of the path when it already has one.
+func (l *link) open(ctx context.Context) (err error) {
+	if l.src.ConnectionID != "" && l.dst.ConnectionID != "" {
+		if err := l.loadClients(ctx); err != nil {
+			return err
+		}
+		return l.openChannel(ctx)
+	}
+
+	if l.src.ClientID = l.src.conf.ClientID; l.src.ClientID == "" {
+		if l.src.ClientID, err = createClient(ctx, l.dst.endpoint, l.src.endpoint); err != nil {
+			return err
+		}
+	}
+
+	if l.dst.ClientID = l.dst.conf.ClientID; l.dst.ClientID == "" {
+		if l.dst.ClientID, err = createClient(ctx, l.src.endpoint, l.dst.endpoint); err != nil {
+			return err
+		}
+	}
+
+	if err := l.openConnection(ctx); err != nil {
+		return err
+	}
+
+	return l.openChannel(ctx)
+}
+
+// openConnection opens a connection between the ends with the connection handshake.
+func (l *link) openConnection(ctx context.Context) error {
+	src, dst := l.src, l.dst
+
+	// ConnOpenInit on src.
+	res, err := src.send(ctx, connectiontypes.NewMsgConnectionOpenInit(
+		src.ClientID,
+		dst.ClientID,
+		ibcPrefix,
+		nil,
+		0,
+		src.signer,
+	))
+	if err != nil {
+		return err
+	}
+
+	if src.ConnectionID, err = eventAttribute(
+		res,
+		connectiontypes.EventTypeConnectionOpenInit,
+		connectiontypes.AttributeKeyConnectionID,
+	); err != nil {
+		return err
+	}
+
+	// ConnOpenTry on dst.
+	proof, err := connectionProof(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := withClientUpdate(ctx, src.endpoint, dst.endpoint, dst.ClientID, proof.height,
+		connectiontypes.NewMsgConnectionOpenTry(
+			"",
+			dst.ClientID,
+			src.ConnectionID,
+			src.ClientID,
+			proof.clientState,
+			ibcPrefix,
+			connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()),
+			0,
+			proof.connection,
+			proof.client,
+			proof.consensus,
+			proof.proofHeight,
+			proof.consensusHeight,
+			dst.signer,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if res, err = dst.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	if dst.ConnectionID, err = eventAttribute(
+		res,
+		connectiontypes.EventTypeConnectionOpenTry,
+		connectiontypes.AttributeKeyConnectionID,
+	); err != nil {
+		return err
+	}
+
+	// ConnOpenAck on src.
+	if proof, err = connectionProof(ctx, dst); err != nil {
+		return err
+	}
+
+	var connection connectiontypes.ConnectionEnd
+	if err := connection.Unmarshal(proof.connectionEnd); err != nil {
+		return err
+	}
+
+	if msgs, err = withClientUpdate(ctx, dst.endpoint, src.endpoint, src.ClientID, proof.height,
+		connectiontypes.NewMsgConnectionOpenAck(
+			src.ConnectionID,
+			dst.ConnectionID,
+			proof.clientState,
+			proof.connection,
+			proof.client,
+			proof.consensus,
+			proof.proofHeight,
+			proof.consensusHeight,
+			connection.Versions[0],
+			src.signer,
+		),
+	); err != nil {
+		return err
+	}
+
+	if _, err := src.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	// ConnOpenConfirm on dst.
+	height, err := src.latestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, proofAck, proofHeight, err := src.queryProof(ctx, host.ConnectionKey(src.ConnectionID), height)
+	if err != nil {
+		return err
+	}
+
+	if msgs, err = withClientUpdate(ctx, src.endpoint, dst.endpoint, dst.ClientID, height,
+		connectiontypes.NewMsgConnectionOpenConfirm(dst.ConnectionID, proofAck, proofHeight, dst.signer),
+	); err != nil {
+		return err
+	}
+
+	_, err = dst.send(ctx, msgs...)
+	return err
+}
+
+// handshakeProof holds the proofs of the state of a connection end
+// that are required to open the counterparty end.
+type handshakeProof struct {
+	height          int64
+	proofHeight     clienttypes.Height
+	consensusHeight clienttypes.Height
+
+	connectionEnd []byte
+	clientState   exported.ClientState
+
+	connection, client, consensus []byte
+}
+
+// connectionProof queries the proofs of the connection of end and its client of the counterparty.
+func connectionProof(ctx context.Context, end *pathEnd) (handshakeProof, error) {
+	height, err := end.latestHeight(ctx)
+	if err != nil {
+		return handshakeProof{}, err
+	}
+
+	p := handshakeProof{height: height}
+
+	p.connectionEnd, p.connection, p.proofHeight, err = end.queryProof(ctx, host.ConnectionKey(end.ConnectionID), height)
+	if err != nil {
+		return handshakeProof{}, err
+	}
+
+	clientStateValue, proofClient, _, err := end.queryProof(ctx, host.FullClientStateKey(end.ClientID), height)
+	if err != nil {
+		return handshakeProof{}, err
+	}
+	p.client = proofClient
+
+	if err := end.client.Context().Codec.UnmarshalInterface(clientStateValue, &p.clientState); err != nil {
+		return handshakeProof{}, err
+	}
+
+	p.consensusHeight = p.clientState.GetLatestHeight().(clienttypes.Height)
+
+	if _, p.consensus, _, err = end.queryProof(
+		ctx,
+		host.FullConsensusStateKey(end.ClientID, p.consensusHeight),
+		height,
+	); err != nil {
+		return handshakeProof{}, err
+	}
+
+	return p, nil
+}
+
+// openChannel opens a channel between the ends with the channel handshake.
+func (l *link) openChannel(ctx context.Context) error {
+	src, dst := l.src, l.dst
+
+	ordering := channeltypes.UNORDERED
+	if l.path.Ordering != "" {
+		o, ok := channeltypes.Order_value[l.path.Ordering]
+		if !ok {
+			return fmt.Errorf("invalid channel ordering %q", l.path.Ordering)
+		}
+		ordering = channeltypes.Order(o)
+	}
+
+	// ChanOpenInit on src.
+	res, err := src.send(ctx, channeltypes.NewMsgChannelOpenInit(
+		src.PortID,
+		src.Version,
+		ordering,
+		[]string{src.ConnectionID},
+		dst.PortID,
+		src.signer,
+	))
+	if err != nil {
+		return err
+	}
+
+	if src.ChannelID, err = eventAttribute(
+		res,
+		channeltypes.EventTypeChannelOpenInit,
+		channeltypes.AttributeKeyChannelID,
+	); err != nil {
+		return err
+	}
+
+	// ChanOpenTry on dst.
+	height, channel, proofInit, proofHeight, err := channelProof(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := withClientUpdate(ctx, src.endpoint, dst.endpoint, dst.ClientID, height,
+		channeltypes.NewMsgChannelOpenTry(
+			dst.PortID,
+			"",
+			dst.Version,
+			ordering,
+			[]string{dst.ConnectionID},
+			src.PortID,
+			src.ChannelID,
+			channel.Version,
+			proofInit,
+			proofHeight,
+			dst.signer,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if res, err = dst.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	if dst.ChannelID, err = eventAttribute(
+		res,
+		channeltypes.EventTypeChannelOpenTry,
+		channeltypes.AttributeKeyChannelID,
+	); err != nil {
+		return err
+	}
+
+	// ChanOpenAck on src.
+	height, channel, proofTry, proofHeight, err := channelProof(ctx, dst)
+	if err != nil {
+		return err
+	}
+
+	if msgs, err = withClientUpdate(ctx, dst.endpoint, src.endpoint, src.ClientID, height,
+		channeltypes.NewMsgChannelOpenAck(
+			src.PortID,
+			src.ChannelID,
+			dst.ChannelID,
+			channel.Version,
+			proofTry,
+			proofHeight,
+			src.signer,
+		),
+	); err != nil {
+		return err
+	}
+
+	if _, err := src.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	// ChanOpenConfirm on dst.
+	height, _, proofAck, proofHeight, err := channelProof(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	if msgs, err = withClientUpdate(ctx, src.endpoint, dst.endpoint, dst.ClientID, height,
+		channeltypes.NewMsgChannelOpenConfirm(dst.PortID, dst.ChannelID, proofAck, proofHeight, dst.signer),
+	); err != nil {
+		return err
+	}
+
+	_, err = dst.send(ctx, msgs...)
+	return err
+}
+
+// channelProof queries the channel of end with its proof at the latest height.
+func channelProof(ctx context.Context, end *pathEnd) (
+	height int64, channel channeltypes.Channel, proof []byte, proofHeight clienttypes.Height, err error) {
+	if height, err = end.latestHeight(ctx); err != nil {
+		return 0, channel, nil, proofHeight, err
+	}
+
+	value, proof, proofHeight, err := end.queryProof(ctx, host.ChannelKey(end.PortID, end.ChannelID), height)
+	if err != nil {
+		return 0, channel, nil, proofHeight, err
+	}
+
+	if err := channel.Unmarshal(value); err != nil {
+		return 0, channel, nil, proofHeight, err
+	}
+
+	return height, channel, proof, proofHeight, nil
+}
@@ -0,0 +1,659 @@
+package relayer
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// maxPacketsPerTx is the max number of packets or acks relayed in a single tx.
+const maxPacketsPerTx = 50
+
+// loadClients sets the client ids of the ends from their connections.
+func (l *link) loadClients(ctx context.Context) error {
+	for _, end := range []*pathEnd{l.src, l.dst} {
+		res, err := connectiontypes.NewQueryClient(end.client.Context()).Connection(
+			ctx,
+			&connectiontypes.QueryConnectionRequest{ConnectionId: end.ConnectionID},
+		)
+		if err != nil {
+			return err
+		}
+		end.ClientID = res.Connection.ClientId
+	}
+	return nil
+}
+
+// packetChain is a chain of a path that packets and acks are relayed between,
+// it is implemented by pathEnd.
+type packetChain interface {
+	// height returns the IBC height of the block at height.
+	height(height int64) clienttypes.Height
+
+	// latestHeight returns the height of the latest block.
+	latestHeight(ctx context.Context) (int64, error)
+
+	// signedHeader returns the header and the commit of the block at height.
+	signedHeader(ctx context.Context, height int64) (*tmtypes.SignedHeader, error)
+
+	// lightHeader returns the header of the block at height that is verified by a client
+	// of the chain that trusts the block at trustedHeight.
+	lightHeader(ctx context.Context, trustedHeight clienttypes.Height, height int64) (*ibctmtypes.Header, error)
+
+	// queryProof returns the value of the IBC store key and its proof at the state of
+	// the block at height.
+	queryProof(ctx context.Context, key []byte, height int64) (value, proof []byte, proofHeight clienttypes.Height, err error)
+
+	// counterpartyClient returns the id of the client of the counterparty chain on the chain.
+	counterpartyClient() string
+
+	// counterpartyClientHeight returns the latest height trusted by the client of the counterparty chain.
+	counterpartyClientHeight(ctx context.Context) (clienttypes.Height, error)
+
+	// signerAddress returns the address that signs the txs sent to the chain.
+	signerAddress() string
+
+	// packetCommitments returns the sequences of the packets committed by the chain at height.
+	packetCommitments(ctx context.Context, height int64) ([]uint64, error)
+
+	// packetAcks returns the sequences of the acks written by the chain at height.
+	packetAcks(ctx context.Context, height int64) ([]uint64, error)
+
+	// packetsNotReceived returns the sequences of the packets that the chain didn't receive.
+	packetsNotReceived(ctx context.Context, sequences []uint64) ([]uint64, error)
+
+	// acksNotReceived returns the sequences of the packets that the chain didn't receive the ack of.
+	acksNotReceived(ctx context.Context, sequences []uint64) ([]uint64, error)
+
+	// sentPacket returns the packet with sequence sent from the chain.
+	sentPacket(ctx context.Context, sequence uint64) (channeltypes.Packet, error)
+
+	// writtenAck returns the ack written by the chain for the received packet with sequence.
+	writtenAck(ctx context.Context, sequence uint64) (channeltypes.Packet, []byte, error)
+
+	// send broadcasts msgs in a single tx and waits for the next block.
+	send(ctx context.Context, msgs ...sdk.Msg) (cosmosclient.Response, error)
+}
+
+// relay relays the pending packets and acks of the path in both directions once.
+func (l *link) relay(ctx context.Context) error {
+	ordered := l.path.Ordering == OrderingOrdered
+
+	for _, ends := range [][2]*pathEnd{{l.src, l.dst}, {l.dst, l.src}} {
+		height, err := relayPackets(ctx, ends[0], ends[1], ordered)
+		if err != nil {
+			return err
+		}
+		ends[0].PacketHeight = height
+	}
+
+	for _, ends := range [][2]*pathEnd{{l.src, l.dst}, {l.dst, l.src}} {
+		height, err := relayAcks(ctx, ends[0], ends[1])
+		if err != nil {
+			return err
+		}
+		ends[1].AckHeight = height
+	}
+
+	if err := refreshClient(ctx, l.dst.endpoint, l.src.endpoint, l.src.ClientID); err != nil {
+		return err
+	}
+	return refreshClient(ctx, l.src.endpoint, l.dst.endpoint, l.dst.ClientID)
+}
+
+// relayPackets relays the packets sent from src that aren't received by dst yet and
+// returns the height of src that the packets are relayed up to.
+// packets that timed out on dst are sent back to src as timeouts.
+func relayPackets(ctx context.Context, src, dst packetChain, ordered bool) (int64, error) {
+	height, err := src.latestHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sequences, err := unreceivedPackets(ctx, src, dst, height)
+	if err != nil {
+		return 0, err
+	}
+
+	dstHeight, err := dst.latestHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	dstHeader, err := dst.signedHeader(ctx, dstHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	var recvs, timeouts []sdk.Msg
+
+	for _, sequence := range sequences {
+		packet, err := src.sentPacket(ctx, sequence)
+		if err != nil {
+			return 0, err
+		}
+
+		if packetTimedOut(packet, dst.height(dstHeight), uint64(dstHeader.Time.UnixNano())) {
+			msg, err := timeoutMsg(ctx, src, dst, packet, dstHeight, ordered)
+			if err != nil {
+				return 0, err
+			}
+			timeouts = append(timeouts, msg)
+			continue
+		}
+
+		_, proof, proofHeight, err := src.queryProof(
+			ctx,
+			host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence),
+			height,
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		recvs = append(recvs, channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, dst.signerAddress()))
+	}
+
+	if err := sendBatches(ctx, src, dst, height, recvs); err != nil {
+		return 0, err
+	}
+
+	if err := sendBatches(ctx, dst, src, dstHeight, timeouts); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+// relayAcks relays the acks written by dst for the packets sent from src that src
+// didn't receive yet and returns the height of dst that the acks are relayed up to.
+func relayAcks(ctx context.Context, src, dst packetChain) (int64, error) {
+	height, err := dst.latestHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sequences, err := unreceivedAcks(ctx, src, dst, height)
+	if err != nil {
+		return 0, err
+	}
+
+	var acks []sdk.Msg
+
+	for _, sequence := range sequences {
+		packet, ack, err := dst.writtenAck(ctx, sequence)
+		if err != nil {
+			return 0, err
+		}
+
+		_, proof, proofHeight, err := dst.queryProof(
+			ctx,
+			host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+			height,
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		acks = append(acks, channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, src.signerAddress()))
+	}
+
+	if err := sendBatches(ctx, dst, src, height, acks); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+// sendBatches sends msgs to dst in txs of up to maxPacketsPerTx msgs after updating
+// the client of src on dst to height, so the proofs of src at height can be verified.
+func sendBatches(ctx context.Context, src, dst packetChain, height int64, msgs []sdk.Msg) error {
+	for len(msgs) > 0 {
+		n := len(msgs)
+		if n > maxPacketsPerTx {
+			n = maxPacketsPerTx
+		}
+
+		batch := msgs[:n]
+		update, err := clientUpdate(ctx, src, dst, height)
+		if err != nil {
+			return err
+		}
+		if update != nil {
+			batch = append([]sdk.Msg{update}, batch...)
+		}
+
+		if _, err := dst.send(ctx, batch...); err != nil {
+			return err
+		}
+
+		msgs = msgs[n:]
+	}
+
+	return nil
+}
+
+// clientUpdate returns a msg that updates the client of src on dst to height.
+// a nil msg is returned when the client is already at height.
+func clientUpdate(ctx context.Context, src, dst packetChain, height int64) (sdk.Msg, error) {
+	trustedHeight, err := dst.counterpartyClientHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if trustedHeight.RevisionHeight >= uint64(height) {
+		return nil, nil
+	}
+
+	header, err := src.lightHeader(ctx, trustedHeight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return clienttypes.NewMsgUpdateClient(dst.counterpartyClient(), header, dst.signerAddress())
+}
+
+// timeoutMsg returns a msg that proves to src that packet wasn't received by dst before it timed out.
+func timeoutMsg(ctx context.Context, src, dst packetChain, packet channeltypes.Packet, height int64, ordered bool) (
+	sdk.Msg, error) {
+	key := host.PacketReceiptKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	if ordered {
+		key = host.NextSequenceRecvKey(packet.DestinationPort, packet.DestinationChannel)
+	}
+
+	value, proof, proofHeight, err := dst.queryProof(ctx, key, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the next sequence to receive is only verified for ordered channels.
+	nextSequenceRecv := packet.Sequence
+	if ordered {
+		nextSequenceRecv = binary.BigEndian.Uint64(value)
+	}
+
+	return channeltypes.NewMsgTimeout(packet, nextSequenceRecv, proof, proofHeight, src.signerAddress()), nil
+}
+
+// packetTimedOut checks if packet timed out on its destination at height and timestamp.
+func packetTimedOut(packet channeltypes.Packet, height clienttypes.Height, timestamp uint64) bool {
+	if !packet.TimeoutHeight.IsZero() && height.GTE(packet.TimeoutHeight) {
+		return true
+	}
+	return packet.TimeoutTimestamp != 0 && timestamp >= packet.TimeoutTimestamp
+}
+
+// unreceivedPackets returns the sequences of the packets committed by src at height
+// that dst didn't receive yet.
+func unreceivedPackets(ctx context.Context, src, dst packetChain, height int64) ([]uint64, error) {
+	sequences, err := src.packetCommitments(ctx, height)
+	if err != nil || len(sequences) == 0 {
+		return nil, err
+	}
+	return dst.packetsNotReceived(ctx, sequences)
+}
+
+// unreceivedAcks returns the sequences of the acks written by dst at height for the
+// packets of src that src didn't receive yet.
+func unreceivedAcks(ctx context.Context, src, dst packetChain, height int64) ([]uint64, error) {
+	sequences, err := dst.packetAcks(ctx, height)
+	if err != nil || len(sequences) == 0 {
+		return nil, err
+	}
+	return src.acksNotReceived(ctx, sequences)
+}
+
+func (e *pathEnd) counterpartyClient() string {
+	return e.ClientID
+}
+
+func (e *pathEnd) counterpartyClientHeight(ctx context.Context) (clienttypes.Height, error) {
+	state, err := clientState(ctx, e.endpoint, e.ClientID)
+	if err != nil {
+		return clienttypes.Height{}, err
+	}
+	return state.LatestHeight, nil
+}
+
+func (e *pathEnd) signerAddress() string {
+	return e.signer
+}
+
+func (e *pathEnd) packetCommitments(ctx context.Context, height int64) ([]uint64, error) {
+	var commitments []*channeltypes.PacketState
+
+	if err := paginate(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := channeltypes.NewQueryClient(provedContext(e, height)).PacketCommitments(
+			ctx,
+			&channeltypes.QueryPacketCommitmentsRequest{
+				PortId:     e.PortID,
+				ChannelId:  e.ChannelID,
+				Pagination: page,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		commitments = append(commitments, res.Commitments...)
+		return res.Pagination, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return packetSequences(commitments), nil
+}
+
+func (e *pathEnd) packetAcks(ctx context.Context, height int64) ([]uint64, error) {
+	var acks []*channeltypes.PacketState
+
+	if err := paginate(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := channeltypes.NewQueryClient(provedContext(e, height)).PacketAcknowledgements(
+			ctx,
+			&channeltypes.QueryPacketAcknowledgementsRequest{
+				PortId:     e.PortID,
+				ChannelId:  e.ChannelID,
+				Pagination: page,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		acks = append(acks, res.Acknowledgements...)
+		return res.Pagination, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return packetSequences(acks), nil
+}
+
+func (e *pathEnd) packetsNotReceived(ctx context.Context, sequences []uint64) ([]uint64, error) {
+	res, err := channeltypes.NewQueryClient(e.client.Context()).UnreceivedPackets(
+		ctx,
+		&channeltypes.QueryUnreceivedPacketsRequest{
+			PortId:                    e.PortID,
+			ChannelId:                 e.ChannelID,
+			PacketCommitmentSequences: sequences,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Sequences, nil
+}
+
+func (e *pathEnd) acksNotReceived(ctx context.Context, sequences []uint64) ([]uint64, error) {
+	res, err := channeltypes.NewQueryClient(e.client.Context()).UnreceivedAcks(
+		ctx,
+		&channeltypes.QueryUnreceivedAcksRequest{
+			PortId:             e.PortID,
+			ChannelId:          e.ChannelID,
+			PacketAckSequences: sequences,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Sequences, nil
+}
+
+// sentPacket returns the packet with sequence sent from e.
+// packets aren't stored by the chains, so they're read from the events of the txs that sent them.
+func (e *pathEnd) sentPacket(ctx context.Context, sequence uint64) (channeltypes.Packet, error) {
+	attrs, err := searchPacketEvent(ctx, e, channeltypes.EventTypeSendPacket, map[string]string{
+		channeltypes.AttributeKeySrcPort:    e.PortID,
+		channeltypes.AttributeKeySrcChannel: e.ChannelID,
+		channeltypes.AttributeKeySequence:   strconv.FormatUint(sequence, 10),
+	})
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	return parsePacket(attrs)
+}
+
+// writtenAck returns the ack written by e for the received packet with sequence.
+func (e *pathEnd) writtenAck(ctx context.Context, sequence uint64) (channeltypes.Packet, []byte, error) {
+	attrs, err := searchPacketEvent(ctx, e, channeltypes.EventTypeWriteAck, map[string]string{
+		channeltypes.AttributeKeyDstPort:    e.PortID,
+		channeltypes.AttributeKeyDstChannel: e.ChannelID,
+		channeltypes.AttributeKeySequence:   strconv.FormatUint(sequence, 10),
+	})
+	if err != nil {
+		return channeltypes.Packet{}, nil, err
+	}
+
+	packet, err := parsePacket(attrs)
+	if err != nil {
+		return channeltypes.Packet{}, nil, err
+	}
+
+	ack, err := hex.DecodeString(attrs[channeltypes.AttributeKeyAckHex])
+	if err != nil {
+		return channeltypes.Packet{}, nil, err
+	}
+
+	return packet, ack, nil
+}
+
+// searchPacketEvent searches the txs of end for the event with eventType that has
+// all the attributes of match and returns the attributes of the event.
+func searchPacketEvent(ctx context.Context, end *pathEnd, eventType string, match map[string]string) (
+	map[string]string, error) {
+	var q string
+	for key, value := range match {
+		if q != "" {
+			q += " AND "
+		}
+		q += fmt.Sprintf("%s.%s='%s'", eventType, key, value)
+	}
+
+	res, err := end.client.RPC.TxSearch(ctx, q, false, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range res.Txs {
+		for _, event := range tx.TxResult.Events {
+			if event.Type != eventType {
+				continue
+			}
+			if attrs := eventAttributes(event); attributesMatch(attrs, match) {
+				return attrs, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %s event not found for %s", end.conf.ID, eventType, q)
+}
+
+// parsePacket parses a packet from the attributes of a packet event.
+func parsePacket(attrs map[string]string) (packet channeltypes.Packet, err error) {
+	if packet.Sequence, err = strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64); err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	if packet.Data, err = hex.DecodeString(attrs[channeltypes.AttributeKeyDataHex]); err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	if packet.TimeoutHeight, err = clienttypes.ParseHeight(attrs[channeltypes.AttributeKeyTimeoutHeight]); err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	if packet.TimeoutTimestamp, err = strconv.ParseUint(attrs[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64); err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	packet.SourcePort = attrs[channeltypes.AttributeKeySrcPort]
+	packet.SourceChannel = attrs[channeltypes.AttributeKeySrcChannel]
+	packet.DestinationPort = attrs[channeltypes.AttributeKeyDstPort]
+	packet.DestinationChannel = attrs[channeltypes.AttributeKeyDstChannel]
+
+	return packet, nil
+}
+
+func eventAttributes(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	return attrs
+}
+
+func attributesMatch(attrs, match map[string]string) bool {
+	for key, value := range match {
+		if attrs[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// provedContext returns a client context to query the state of end that is proved at height.
+func provedContext(end *pathEnd, height int64) client.Context {
+	return end.client.Context().WithHeight(provedStateHeight(height))
+}
+
+// provedStateHeight returns the height of the state that is proved by the block at height,
+// the app hash of a block commits the state of the previous block.
+func provedStateHeight(height int64) int64 {
+	return height - 1
+}
+
+// provingHeight returns the height of the block that proves the state at stateHeight,
+// clients verify the proofs of the state with the consensus state of this block.
+func provingHeight(stateHeight int64) int64 {
+	return stateHeight + 1
+}
+
+// paginate calls query with the next page until all pages are queried.
+func paginate(fn func(page *query.PageRequest) (*query.PageResponse, error)) error {
+	page := &query.PageRequest{}
+	for {
+		res, err := fn(page)
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+		page = &query.PageRequest{Key: res.NextKey}
+	}
+}
+
+func packetSequences(states []*channeltypes.PacketState) []uint64 {
+	sequences := make([]uint64, len(states))
+	for i, s := range states {
+		sequences[i] = s.Sequence
+	}
+	return sequences
+}
+
+// start relays the packets of the link each time one of its chains produces new blocks
+// until ctx is canceled. onRelay is called with the path after each relay. failed queries
+// and relays are retried with a backoff and onRetry is called with their error.
+func (l *link) start(
+	ctx context.Context,
+	onRelay func(relayerconf.Path) error,
+	onRetry func(err error, next time.Duration),
+) error {
+	var srcHeight, dstHeight int64
+
+	relay := func() error {
+		g, gctx := errgroup.WithContext(ctx)
+		var newSrcHeight, newDstHeight int64
+		g.Go(func() (err error) {
+			newSrcHeight, err = l.src.latestHeight(gctx)
+			return err
+		})
+		g.Go(func() (err error) {
+			newDstHeight, err = l.dst.latestHeight(gctx)
+			return err
+		})
+		if err := g.Wait(); err != nil {
+			return err
+		}
+
+		if newSrcHeight == srcHeight && newDstHeight == dstHeight {
+			return nil
+		}
+
+		if err := l.relay(ctx); err != nil {
+			return err
+		}
+
+		// the heights are only kept once relayed so a failed relay is retried without new blocks.
+		srcHeight, dstHeight = newSrcHeight, newDstHeight
+
+		if err := onRelay(l.Path()); err != nil {
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	return poll(ctx, heightPollInterval, newRetryBackOff(), relay, onRetry)
+}
+
+// newRetryBackOff returns the backoff to retry failed relays, it never stops retrying.
+func newRetryBackOff() backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = heightPollInterval
+	b.MaxInterval = maxRetryInterval
+	b.MaxElapsedTime = 0
+	return b
+}
+
+// poll calls fn at each interval until ctx is canceled or fn returns a permanent error.
+// other errors are passed to onRetry and fn is called again after the next backoff of b.
+func poll(
+	ctx context.Context,
+	interval time.Duration,
+	b backoff.BackOff,
+	fn func() error,
+	onRetry func(err error, next time.Duration),
+) error {
+	for {
+		next := interval
+
+		if err := fn(); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			var permanent *backoff.PermanentError
+			if errors.As(err, &permanent) {
+				return permanent.Err
+			}
+
+			next = b.NextBackOff()
+			onRetry(err, next)
+		} else {
+			b.Reset()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(next):
+		}
+	}
+}
@@ -0,0 +1,446 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+)
+
+// fakeChain is a packetChain that keeps the IBC state of a chain in memory.
+type fakeChain struct {
+	id            string
+	latest        int64
+	time          time.Time
+	signer        string
+	clientID      string
+	trustedHeight clienttypes.Height
+
+	// commitments and acks are the sequences of the packets sent and acknowledged by the chain.
+	commitments, acks []uint64
+
+	// received and acked are the sequences of the packets received and the acks received by the chain.
+	received, acked map[uint64]bool
+
+	packets          map[uint64]channeltypes.Packet
+	writtenAcks      map[uint64][]byte
+	nextSequenceRecv uint64
+
+	// proofKeys and proofHeights are the keys and the heights that proofs are queried for.
+	proofKeys    [][]byte
+	proofHeights []int64
+
+	txs [][]sdk.Msg
+}
+
+func newFakeChain(id string, latest int64) *fakeChain {
+	return &fakeChain{
+		id:          id,
+		latest:      latest,
+		time:        time.Unix(1000, 0),
+		signer:      id + "-relayer",
+		clientID:    "07-tendermint-0",
+		received:    make(map[uint64]bool),
+		acked:       make(map[uint64]bool),
+		packets:     make(map[uint64]channeltypes.Packet),
+		writtenAcks: make(map[uint64][]byte),
+	}
+}
+
+func (c *fakeChain) height(height int64) clienttypes.Height {
+	return clienttypes.NewHeight(0, uint64(height))
+}
+
+func (c *fakeChain) latestHeight(context.Context) (int64, error) {
+	return c.latest, nil
+}
+
+func (c *fakeChain) signedHeader(_ context.Context, height int64) (*tmtypes.SignedHeader, error) {
+	return &tmtypes.SignedHeader{Header: &tmtypes.Header{ChainID: c.id, Height: height, Time: c.time}}, nil
+}
+
+func (c *fakeChain) lightHeader(_ context.Context, trustedHeight clienttypes.Height, height int64) (
+	*ibctmtypes.Header, error) {
+	return &ibctmtypes.Header{
+		SignedHeader:  &tmproto.SignedHeader{Header: &tmproto.Header{ChainID: c.id, Height: height}},
+		TrustedHeight: trustedHeight,
+	}, nil
+}
+
+func (c *fakeChain) queryProof(_ context.Context, key []byte, height int64) (
+	value, proof []byte, proofHeight clienttypes.Height, err error) {
+	c.proofKeys = append(c.proofKeys, key)
+	c.proofHeights = append(c.proofHeights, height)
+
+	if bytes.HasPrefix(key, []byte(host.KeyNextSeqRecvPrefix)) {
+		value = make([]byte, 8)
+		binary.BigEndian.PutUint64(value, c.nextSequenceRecv)
+	}
+	return value, []byte("proof"), c.height(height), nil
+}
+
+func (c *fakeChain) counterpartyClient() string {
+	return c.clientID
+}
+
+func (c *fakeChain) counterpartyClientHeight(context.Context) (clienttypes.Height, error) {
+	return c.trustedHeight, nil
+}
+
+func (c *fakeChain) signerAddress() string {
+	return c.signer
+}
+
+func (c *fakeChain) packetCommitments(context.Context, int64) ([]uint64, error) {
+	return c.commitments, nil
+}
+
+func (c *fakeChain) packetAcks(context.Context, int64) ([]uint64, error) {
+	return c.acks, nil
+}
+
+func (c *fakeChain) packetsNotReceived(_ context.Context, sequences []uint64) (unreceived []uint64, err error) {
+	for _, sequence := range sequences {
+		if !c.received[sequence] {
+			unreceived = append(unreceived, sequence)
+		}
+	}
+	return unreceived, nil
+}
+
+func (c *fakeChain) acksNotReceived(_ context.Context, sequences []uint64) (unreceived []uint64, err error) {
+	for _, sequence := range sequences {
+		if !c.acked[sequence] {
+			unreceived = append(unreceived, sequence)
+		}
+	}
+	return unreceived, nil
+}
+
+func (c *fakeChain) sentPacket(_ context.Context, sequence uint64) (channeltypes.Packet, error) {
+	return c.packets[sequence], nil
+}
+
+func (c *fakeChain) writtenAck(_ context.Context, sequence uint64) (channeltypes.Packet, []byte, error) {
+	return c.packets[sequence], c.writtenAcks[sequence], nil
+}
+
+// send records msgs and updates the trusted height of the client when msgs update it.
+func (c *fakeChain) send(_ context.Context, msgs ...sdk.Msg) (cosmosclient.Response, error) {
+	c.txs = append(c.txs, msgs)
+	for _, msg := range msgs {
+		if update, ok := msg.(*clienttypes.MsgUpdateClient); ok {
+			header, err := clienttypes.UnpackHeader(update.Header)
+			if err != nil {
+				return cosmosclient.Response{}, err
+			}
+			c.trustedHeight = header.GetHeight().(clienttypes.Height)
+		}
+	}
+	return cosmosclient.Response{}, nil
+}
+
+// packet returns a packet with sequence sent from src to dst.
+func packet(sequence uint64, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) channeltypes.Packet {
+	return channeltypes.NewPacket(
+		[]byte("{}"),
+		sequence,
+		"transfer",
+		"channel-0",
+		"transfer",
+		"channel-1",
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+}
+
+// requireClientUpdate checks that msg updates the client with clientID to height from trustedHeight.
+func requireClientUpdate(t *testing.T, msg sdk.Msg, clientID string, trustedHeight, height uint64) {
+	t.Helper()
+
+	update, ok := msg.(*clienttypes.MsgUpdateClient)
+	require.True(t, ok, "%T is not a client update", msg)
+	require.Equal(t, clientID, update.ClientId)
+
+	header, err := clienttypes.UnpackHeader(update.Header)
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(0, height), header.GetHeight())
+	require.Equal(t, clienttypes.NewHeight(0, trustedHeight), header.(*ibctmtypes.Header).TrustedHeight)
+}
+
+func TestParsePacket(t *testing.T) {
+	attrs := map[string]string{
+		channeltypes.AttributeKeySequence:         "3",
+		channeltypes.AttributeKeyDataHex:          "7b7d",
+		channeltypes.AttributeKeyTimeoutHeight:    "1-100",
+		channeltypes.AttributeKeyTimeoutTimestamp: "0",
+		channeltypes.AttributeKeySrcPort:          "transfer",
+		channeltypes.AttributeKeySrcChannel:       "channel-0",
+		channeltypes.AttributeKeyDstPort:          "transfer",
+		channeltypes.AttributeKeyDstChannel:       "channel-1",
+	}
+
+	packet, err := parsePacket(attrs)
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.NewPacket(
+		[]byte("{}"),
+		3,
+		"transfer",
+		"channel-0",
+		"transfer",
+		"channel-1",
+		clienttypes.NewHeight(1, 100),
+		0,
+	), packet)
+
+	attrs[channeltypes.AttributeKeyDataHex] = "invalid"
+	_, err = parsePacket(attrs)
+	require.Error(t, err)
+}
+
+func TestPacketTimedOut(t *testing.T) {
+	packet := channeltypes.Packet{TimeoutHeight: clienttypes.NewHeight(0, 10)}
+	require.False(t, packetTimedOut(packet, clienttypes.NewHeight(0, 9), 0))
+	require.True(t, packetTimedOut(packet, clienttypes.NewHeight(0, 10), 0))
+
+	packet = channeltypes.Packet{TimeoutTimestamp: 100}
+	require.False(t, packetTimedOut(packet, clienttypes.NewHeight(0, 1000), 99))
+	require.True(t, packetTimedOut(packet, clienttypes.NewHeight(0, 1), 100))
+}
+
+func TestProofHeights(t *testing.T) {
+	// the state of the block 9 is proved by the app hash of the block 10,
+	// so the proofs queried for the block 10 are verified with its consensus state.
+	require.Equal(t, int64(9), provedStateHeight(10))
+	require.Equal(t, int64(10), provingHeight(provedStateHeight(10)))
+}
+
+func TestRelayPackets(t *testing.T) {
+	var (
+		ctx = context.Background()
+		src = newFakeChain("mars", 20)
+		dst = newFakeChain("venus", 10)
+	)
+
+	src.commitments = []uint64{1, 2, 3, 4}
+	src.packets[2] = packet(2, clienttypes.NewHeight(0, 100), 0)
+	src.packets[3] = packet(3, clienttypes.NewHeight(0, 10), 0)
+	src.packets[4] = packet(4, clienttypes.ZeroHeight(), uint64(dst.time.UnixNano()))
+	src.trustedHeight = clienttypes.NewHeight(0, 4)
+	dst.received[1] = true
+	dst.trustedHeight = clienttypes.NewHeight(0, 15)
+
+	height, err := relayPackets(ctx, src, dst, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), height)
+
+	// the packet is received by dst with a proof of src at its latest height after
+	// the client of src on dst is updated to this height.
+	require.Equal(t, []int64{20}, src.proofHeights)
+	require.Len(t, dst.txs, 1)
+	require.Len(t, dst.txs[0], 2)
+	requireClientUpdate(t, dst.txs[0][0], dst.clientID, 15, 20)
+	require.Equal(t, channeltypes.NewMsgRecvPacket(
+		src.packets[2],
+		[]byte("proof"),
+		clienttypes.NewHeight(0, 20),
+		dst.signer,
+	), dst.txs[0][1])
+
+	// packets that timed out on dst by height or timestamp are sent back to src with
+	// a proof of dst that they are not received.
+	require.Equal(t, []int64{10, 10}, dst.proofHeights)
+	require.Equal(t, [][]byte{
+		host.PacketReceiptKey("transfer", "channel-1", 3),
+		host.PacketReceiptKey("transfer", "channel-1", 4),
+	}, dst.proofKeys)
+	require.Len(t, src.txs, 1)
+	require.Len(t, src.txs[0], 3)
+	requireClientUpdate(t, src.txs[0][0], src.clientID, 4, 10)
+	require.Equal(t, channeltypes.NewMsgTimeout(
+		src.packets[3],
+		3,
+		[]byte("proof"),
+		clienttypes.NewHeight(0, 10),
+		src.signer,
+	), src.txs[0][1])
+	require.Equal(t, channeltypes.NewMsgTimeout(
+		src.packets[4],
+		4,
+		[]byte("proof"),
+		clienttypes.NewHeight(0, 10),
+		src.signer,
+	), src.txs[0][2])
+}
+
+func TestRelayPacketsClientUpToDate(t *testing.T) {
+	var (
+		src = newFakeChain("mars", 20)
+		dst = newFakeChain("venus", 10)
+	)
+
+	src.commitments = []uint64{1}
+	src.packets[1] = packet(1, clienttypes.NewHeight(0, 100), 0)
+	dst.trustedHeight = clienttypes.NewHeight(0, 20)
+
+	_, err := relayPackets(context.Background(), src, dst, false)
+	require.NoError(t, err)
+
+	// the client is not updated when it already trusts the proof height.
+	require.Len(t, dst.txs, 1)
+	require.Len(t, dst.txs[0], 1)
+	require.IsType(t, &channeltypes.MsgRecvPacket{}, dst.txs[0][0])
+}
+
+func TestRelayPacketsOrderedTimeout(t *testing.T) {
+	var (
+		src = newFakeChain("mars", 20)
+		dst = newFakeChain("venus", 10)
+	)
+
+	src.commitments = []uint64{5}
+	src.packets[5] = packet(5, clienttypes.NewHeight(0, 8), 0)
+	dst.nextSequenceRecv = 5
+
+	_, err := relayPackets(context.Background(), src, dst, true)
+	require.NoError(t, err)
+
+	// the next sequence to receive of dst is proved for ordered channels.
+	require.Equal(t, [][]byte{host.NextSequenceRecvKey("transfer", "channel-1")}, dst.proofKeys)
+	require.Empty(t, dst.txs)
+	require.Len(t, src.txs, 1)
+	require.Equal(t, channeltypes.NewMsgTimeout(
+		src.packets[5],
+		5,
+		[]byte("proof"),
+		clienttypes.NewHeight(0, 10),
+		src.signer,
+	), src.txs[0][1])
+}
+
+func TestRelayPacketsBatches(t *testing.T) {
+	var (
+		src = newFakeChain("mars", 20)
+		dst = newFakeChain("venus", 10)
+	)
+
+	for sequence := uint64(1); sequence <= 2*maxPacketsPerTx+1; sequence++ {
+		src.commitments = append(src.commitments, sequence)
+		src.packets[sequence] = packet(sequence, clienttypes.NewHeight(0, 100), 0)
+	}
+
+	_, err := relayPackets(context.Background(), src, dst, false)
+	require.NoError(t, err)
+
+	// the client is only updated with the first batch.
+	require.Len(t, dst.txs, 3)
+	require.Len(t, dst.txs[0], maxPacketsPerTx+1)
+	requireClientUpdate(t, dst.txs[0][0], dst.clientID, 0, 20)
+	require.Len(t, dst.txs[1], maxPacketsPerTx)
+	require.Len(t, dst.txs[2], 1)
+}
+
+func TestRelayAcks(t *testing.T) {
+	var (
+		src = newFakeChain("mars", 20)
+		dst = newFakeChain("venus", 30)
+	)
+
+	src.packets[1] = packet(1, clienttypes.NewHeight(0, 100), 0)
+	src.packets[2] = packet(2, clienttypes.NewHeight(0, 100), 0)
+	src.acked[1] = true
+	src.trustedHeight = clienttypes.NewHeight(0, 25)
+	dst.acks = []uint64{1, 2}
+	dst.packets = src.packets
+	dst.writtenAcks[2] = []byte(`{"result":"AQ=="}`)
+
+	height, err := relayAcks(context.Background(), src, dst)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), height)
+
+	// the ack is proved by dst at its latest height that the client of dst on src is updated to.
+	require.Equal(t, []int64{30}, dst.proofHeights)
+	require.Equal(t, [][]byte{host.PacketAcknowledgementKey("transfer", "channel-1", 2)}, dst.proofKeys)
+	require.Empty(t, dst.txs)
+	require.Len(t, src.txs, 1)
+	require.Len(t, src.txs[0], 2)
+	requireClientUpdate(t, src.txs[0][0], src.clientID, 25, 30)
+	require.Equal(t, channeltypes.NewMsgAcknowledgement(
+		src.packets[2],
+		dst.writtenAcks[2],
+		[]byte("proof"),
+		clienttypes.NewHeight(0, 30),
+		src.signer,
+	), src.txs[0][1])
+}
+
+func TestRelayNothing(t *testing.T) {
+	var (
+		ctx = context.Background()
+		src = newFakeChain("mars", 20)
+		dst = newFakeChain("venus", 30)
+	)
+
+	src.commitments = []uint64{1}
+	dst.received[1] = true
+
+	height, err := relayPackets(ctx, src, dst, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), height)
+
+	height, err = relayAcks(ctx, src, dst)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), height)
+
+	require.Empty(t, src.txs)
+	require.Empty(t, dst.txs)
+}
+
+func TestPoll(t *testing.T) {
+	var (
+		errQuery   = errors.New("rpc timeout")
+		errSave    = errors.New("cannot save the path")
+		calls      int
+		retries    []error
+		backoffs   = backoff.NewConstantBackOff(time.Millisecond)
+		onRetry    = func(err error, _ time.Duration) { retries = append(retries, err) }
+		errsByCall = []error{errQuery, errQuery, nil, errQuery, backoff.Permanent(errSave)}
+	)
+
+	// transient errors are retried until a permanent error stops polling.
+	err := poll(context.Background(), time.Millisecond, backoffs, func() error {
+		err := errsByCall[calls]
+		calls++
+		return err
+	}, onRetry)
+	require.Equal(t, errSave, err)
+	require.Equal(t, len(errsByCall), calls)
+	require.Equal(t, []error{errQuery, errQuery, errQuery}, retries)
+
+	// polling stops when ctx is canceled, even while failing.
+	ctx, cancel := context.WithCancel(context.Background())
+	calls = 0
+	err = poll(ctx, time.Millisecond, backoffs, func() error {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+		return errQuery
+	}, func(error, time.Duration) {})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 3, calls)
+}
@@ -11,36 +11,57 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
-	"github.com/ignite-hq/cli/ignite/pkg/ctxticker"
-	tsrelayer "github.com/ignite-hq/cli/ignite/pkg/nodetime/programs/ts-relayer"
+	"github.com/ignite-hq/cli/ignite/pkg/events"
 	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
 	"github.com/ignite-hq/cli/ignite/pkg/xurl"
 )
 
-const (
-	ibcSetupGas   int64 = 2256000
-	relayDuration       = time.Second * 5
-)
+const ibcSetupGas int64 = 2256000
 
 // Relayer is an IBC relayer.
 type Relayer struct {
-	ca cosmosaccount.Registry
+	ca         cosmosaccount.Registry
+	ev         events.Bus
+	configPath string
+}
+
+// RelayerOption configures the relayer.
+type RelayerOption func(*Relayer)
+
+// CollectEvents collects the events of the relayer.
+func CollectEvents(ev events.Bus) RelayerOption {
+	return func(r *Relayer) {
+		r.ev = ev
+	}
+}
+
+// WithConfigPath uses the relayer config at path instead of the default one.
+func WithConfigPath(path string) RelayerOption {
+	return func(r *Relayer) {
+		r.configPath = path
+	}
 }
 
 // New creates a new IBC relayer and uses ca to access accounts.
-func New(ca cosmosaccount.Registry) Relayer {
-	return Relayer{
-		ca: ca,
+func New(ca cosmosaccount.Registry, options ...RelayerOption) Relayer {
+	r := Relayer{
+		ca:         ca,
+		configPath: relayerconf.DefaultPath(),
 	}
+	for _, apply := range options {
+		apply(&r)
+	}
+	return r
 }
 
 // Link links all chains that has a path to each other.
 // paths are optional and acts as a filter to only link some chains.
 // calling Link multiple times for the same paths does not have any side effects.
 func (r Relayer) Link(ctx context.Context, pathIDs ...string) error {
-	conf, err := relayerconf.Get()
+	conf, err := relayerconf.GetAt(r.configPath)
 	if err != nil {
 		return err
 	}
@@ -55,14 +76,19 @@ func (r Relayer) Link(ctx context.Context, pathIDs ...string) error {
 			continue
 		}
 
-		if path, err = r.call(ctx, conf, path, "link"); err != nil {
+		l, err := r.prepareLink(ctx, conf, path)
+		if err != nil {
+			return err
+		}
+
+		if err := l.open(ctx); err != nil {
 			return err
 		}
 
-		if err := conf.UpdatePath(path); err != nil {
+		if err := conf.UpdatePath(l.Path()); err != nil {
 			return err
 		}
-		if err := relayerconf.Save(conf); err != nil {
+		if err := relayerconf.SaveAt(r.configPath, conf); err != nil {
 			return err
 		}
 	}
@@ -71,8 +97,9 @@ func (r Relayer) Link(ctx context.Context, pathIDs ...string) error {
 }
 
 // Start relays packets for linked paths until ctx is canceled.
+// packets are relayed each time one of the chains of a path produces a new block.
 func (r Relayer) Start(ctx context.Context, pathIDs ...string) error {
-	conf, err := relayerconf.Get()
+	conf, err := relayerconf.GetAt(r.configPath)
 	if err != nil {
 		return err
 	}
@@ -80,84 +107,128 @@ func (r Relayer) Start(ctx context.Context, pathIDs ...string) error {
 	wg, ctx := errgroup.WithContext(ctx)
 	var m sync.Mutex // protects relayerconf.Path.
 
-	start := func(id string) error {
-		path, err := conf.PathByID(id)
+	save := func(path relayerconf.Path) error {
+		m.Lock()
+		defer m.Unlock()
+
+		conf, err := relayerconf.GetAt(r.configPath)
 		if err != nil {
 			return err
 		}
 
-		if path, err = r.call(ctx, conf, path, "start"); err != nil {
+		if err := conf.UpdatePath(path); err != nil {
 			return err
 		}
 
-		m.Lock()
-		defer m.Unlock()
+		return relayerconf.SaveAt(r.configPath, conf)
+	}
+
+	start := func(id string) error {
+		path, err := conf.PathByID(id)
+		if err != nil {
+			return err
+		}
 
-		conf, err := relayerconf.Get()
+		l, err := r.prepareLink(ctx, conf, path)
 		if err != nil {
 			return err
 		}
 
-		if err := conf.UpdatePath(path); err != nil {
+		if err := l.loadClients(ctx); err != nil {
 			return err
 		}
 
-		return relayerconf.Save(conf)
+		retry := func(err error, next time.Duration) {
+			r.ev.Send(events.New(
+				events.StatusDone,
+				fmt.Sprintf("Cannot relay packets of path %s, retrying in %s: %s", id, next.Round(time.Second), err),
+				events.Icon(icons.NotOK),
+			))
+		}
+
+		return l.start(ctx, save, retry)
 	}
 
 	for _, id := range pathIDs {
 		id := id
 
 		wg.Go(func() error {
-			return ctxticker.DoNow(ctx, relayDuration, func() error { return start(id) })
+			return start(id)
 		})
 	}
 
 	return wg.Wait()
 }
 
-func (r Relayer) call(ctx context.Context, conf relayerconf.Config, path relayerconf.Path, action string) (
-	reply relayerconf.Path, err error) {
-	srcChain, srcKey, err := r.prepare(ctx, conf, path.Src.ChainID)
+// Flush relays the pending packets and acks of the linked path with id once.
+func (r Relayer) Flush(ctx context.Context, id string) error {
+	conf, err := relayerconf.GetAt(r.configPath)
 	if err != nil {
-		return relayerconf.Path{}, err
+		return err
 	}
 
-	dstChain, dstKey, err := r.prepare(ctx, conf, path.Dst.ChainID)
+	path, err := conf.PathByID(id)
 	if err != nil {
-		return relayerconf.Path{}, err
+		return err
+	}
+
+	if path.Src.ChannelID == "" {
+		return fmt.Errorf("path %q is not linked", id)
+	}
+
+	l, err := r.prepareLink(ctx, conf, path)
+	if err != nil {
+		return err
+	}
+
+	if err := l.loadClients(ctx); err != nil {
+		return err
+	}
+
+	if err := l.relay(ctx); err != nil {
+		return err
+	}
+
+	if err := conf.UpdatePath(l.Path()); err != nil {
+		return err
 	}
 
-	args := []interface{}{
-		path,
-		srcChain,
-		dstChain,
-		srcKey,
-		dstKey,
+	return relayerconf.SaveAt(r.configPath, conf)
+}
+
+// prepareLink connects to the chains of path after making sure that
+// their relayer accounts have enough balances to send IBC transactions.
+func (r Relayer) prepareLink(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (*link, error) {
+	for _, chainID := range []string{path.Src.ChainID, path.Dst.ChainID} {
+		if err := r.ensureBalance(ctx, conf, chainID); err != nil {
+			return nil, err
+		}
 	}
-	return reply, tsrelayer.Call(ctx, action, args, &reply)
+
+	return r.newLink(ctx, conf, path)
 }
 
-func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string) (
-	chain relayerconf.Chain, privKey string, err error) {
-	chain, err = conf.ChainByID(chainID)
+// ensureBalance makes sure that the relayer account of the chain with chainID
+// has enough balance to send IBC transactions.
+func (r Relayer) ensureBalance(ctx context.Context, conf relayerconf.Config, chainID string) error {
+	chain, err := conf.ChainByID(chainID)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return err
 	}
 
 	coins, err := r.balance(ctx, chain.RPCAddress, chain.Account, chain.AddressPrefix)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return err
 	}
 
 	gasPrice, err := sdk.ParseCoinNormalized(chain.GasPrice)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return err
 	}
 
 	account, err := r.ca.GetByName(chain.Account)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return err
 	}
 
 	errMissingBalance := fmt.Errorf(`account "%s(%s)" on %q chain does not have enough balances`,
@@ -167,7 +238,7 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 	)
 
 	if len(coins) == 0 {
-		return relayerconf.Chain{}, "", errMissingBalance
+		return errMissingBalance
 	}
 
 	for _, coin := range coins {
@@ -176,16 +247,11 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 		}
 
 		if gasPrice.Amount.Int64()*ibcSetupGas > coin.Amount.Int64() {
-			return relayerconf.Chain{}, "", errMissingBalance
+			return errMissingBalance
 		}
 	}
 
-	key, err := r.ca.ExportHex(chain.Account, "")
-	if err != nil {
-		return relayerconf.Chain{}, "", err
-	}
-
-	return chain, key, nil
+	return nil
 }
 
 func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix string) (sdk.Coins, error) {
@@ -212,7 +278,7 @@ func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix
 
 // GetPath returns a path by its id.
 func (r Relayer) GetPath(_ context.Context, id string) (relayerconf.Path, error) {
-	conf, err := relayerconf.Get()
+	conf, err := relayerconf.GetAt(r.configPath)
 	if err != nil {
 		return relayerconf.Path{}, err
 	}
@@ -222,7 +288,7 @@ func (r Relayer) GetPath(_ context.Context, id string) (relayerconf.Path, error)
 
 // ListPaths list all the paths.
 func (r Relayer) ListPaths(_ context.Context) ([]relayerconf.Path, error) {
-	conf, err := relayerconf.Get()
+	conf, err := relayerconf.GetAt(r.configPath)
 	if err != nil {
 		return nil, err
 	}
@@ -230,6 +296,38 @@ func (r Relayer) ListPaths(_ context.Context) ([]relayerconf.Path, error) {
 	return conf.Paths, nil
 }
 
+// RemoveChains removes the chains with chainIDs and the paths between them
+// and other chains from the relayer config.
+func (r Relayer) RemoveChains(_ context.Context, chainIDs ...string) error {
+	conf, err := relayerconf.GetAt(r.configPath)
+	if err != nil {
+		return err
+	}
+
+	removed := make(map[string]bool)
+	for _, id := range chainIDs {
+		removed[id] = true
+	}
+
+	var chains []relayerconf.Chain
+	for _, chain := range conf.Chains {
+		if !removed[chain.ID] {
+			chains = append(chains, chain)
+		}
+	}
+
+	var paths []relayerconf.Path
+	for _, path := range conf.Paths {
+		if !removed[path.Src.ChainID] && !removed[path.Dst.ChainID] {
+			paths = append(paths, path)
+		}
+	}
+
+	conf.Chains, conf.Paths = chains, paths
+
+	return relayerconf.SaveAt(r.configPath, conf)
+}
+
 func fixRPCAddress(rpcAddress string) string {
 	return strings.TrimSuffix(xurl.HTTPEnsurePort(rpcAddress), "/")
 }
@@ -0,0 +1,35 @@
+package relayer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+func TestRemoveChainsWithConfigPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "playground.yml")
+	require.NoError(t, relayerconf.SaveAt(path, relayerconf.Config{
+		Chains: []relayerconf.Chain{{ID: "mars"}, {ID: "venus"}, {ID: "earth"}},
+		Paths: []relayerconf.Path{
+			{ID: "mars-venus", Src: relayerconf.PathEnd{ChainID: "mars"}, Dst: relayerconf.PathEnd{ChainID: "venus"}},
+			{ID: "earth-venus", Src: relayerconf.PathEnd{ChainID: "earth"}, Dst: relayerconf.PathEnd{ChainID: "venus"}},
+		},
+	}))
+
+	r := New(cosmosaccount.Registry{}, WithConfigPath(path))
+	require.NoError(t, r.RemoveChains(context.Background(), "mars"))
+
+	conf, err := relayerconf.GetAt(path)
+	require.NoError(t, err)
+	require.Equal(t, []relayerconf.Chain{{ID: "venus"}, {ID: "earth"}}, conf.Chains)
+
+	paths, err := r.ListPaths(context.Background())
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	require.Equal(t, "earth-venus", paths[0].ID)
+}
@@ -0,0 +1,143 @@
+package relayer
+
+import (
+	"context"
+	"time"
+
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// PathStatus is the status of a path.
+type PathStatus struct {
+	Path relayerconf.Path
+	Src  EndStatus
+	Dst  EndStatus
+}
+
+// EndStatus is the status of an end of a path.
+type EndStatus struct {
+	// ClientID is the id of the client of the counterparty chain.
+	ClientID string
+
+	// ClientHeight is the latest height of the counterparty chain known by the client.
+	ClientHeight string
+
+	// ClientExpiry is the time that the client expires at when it isn't updated.
+	ClientExpiry time.Time
+
+	// ConnectionState and ChannelState are the states of the connection and channel ends.
+	ConnectionState string
+	ChannelState    string
+
+	// UnrelayedPackets are the sequences of the packets sent from the end
+	// that the counterparty didn't receive yet.
+	UnrelayedPackets []uint64
+
+	// UnrelayedAcks are the sequences of the acks written by the end
+	// that the counterparty didn't receive yet.
+	UnrelayedAcks []uint64
+}
+
+// Status returns the status of the paths with pathIDs.
+func (r Relayer) Status(ctx context.Context, pathIDs ...string) ([]PathStatus, error) {
+	conf, err := relayerconf.GetAt(r.configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []PathStatus
+
+	for _, id := range pathIDs {
+		path, err := conf.PathByID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		status := PathStatus{Path: path}
+
+		// paths that aren't linked yet have no state on the chains.
+		if path.Src.ConnectionID == "" {
+			statuses = append(statuses, status)
+			continue
+		}
+
+		l, err := r.newLink(ctx, conf, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := l.loadClients(ctx); err != nil {
+			return nil, err
+		}
+
+		if status.Src, err = endStatus(ctx, l.src, l.dst); err != nil {
+			return nil, err
+		}
+		if status.Dst, err = endStatus(ctx, l.dst, l.src); err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// endStatus returns the status of end whose counterparty is counterparty.
+func endStatus(ctx context.Context, end, counterparty *pathEnd) (EndStatus, error) {
+	status := EndStatus{ClientID: end.ClientID}
+
+	client, err := clientState(ctx, end.endpoint, end.ClientID)
+	if err != nil {
+		return EndStatus{}, err
+	}
+
+	consensus, err := consensusState(ctx, end.endpoint, end.ClientID, client.LatestHeight)
+	if err != nil {
+		return EndStatus{}, err
+	}
+
+	status.ClientHeight = client.LatestHeight.String()
+	status.ClientExpiry = consensus.Timestamp.Add(client.TrustingPeriod)
+
+	connection, err := connectiontypes.NewQueryClient(end.client.Context()).Connection(
+		ctx,
+		&connectiontypes.QueryConnectionRequest{ConnectionId: end.ConnectionID},
+	)
+	if err != nil {
+		return EndStatus{}, err
+	}
+	status.ConnectionState = connection.Connection.State.String()
+
+	// the channel handshake may not be started yet.
+	if end.ChannelID == "" {
+		return status, nil
+	}
+
+	channel, err := channeltypes.NewQueryClient(end.client.Context()).Channel(
+		ctx,
+		&channeltypes.QueryChannelRequest{PortId: end.PortID, ChannelId: end.ChannelID},
+	)
+	if err != nil {
+		return EndStatus{}, err
+	}
+	status.ChannelState = channel.Channel.State.String()
+
+	height, err := end.latestHeight(ctx)
+	if err != nil {
+		return EndStatus{}, err
+	}
+
+	if status.UnrelayedPackets, err = unreceivedPackets(ctx, end, counterparty, height); err != nil {
+		return EndStatus{}, err
+	}
+
+	if status.UnrelayedAcks, err = unreceivedAcks(ctx, counterparty, end, height); err != nil {
+		return EndStatus{}, err
+	}
+
+	return status, nil
+}
@@ -1,9 +1,14 @@
 package xgit
 
 import (
+	"io"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 func AreChangesCommitted(appPath string) (bool, error) {
@@ -31,3 +36,87 @@ func AreChangesCommitted(appPath string) (bool, error) {
 	}
 	return ws.IsClean(), nil
 }
+
+// ExportRevision writes the files of path as they are in the given git revision
+// (a branch, tag or commit hash) into dstPath, without touching the worktree of path.
+// path can be the root of a git repository or any directory inside of it.
+func ExportRevision(path, revision, dstPath string) error {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	repository, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return err
+	}
+
+	w, err := repository.Worktree()
+	if err != nil {
+		return err
+	}
+
+	// files in git trees are relative to the repository root.
+	prefix, err := filepath.Rel(w.Filesystem.Root(), path)
+	if err != nil {
+		return err
+	}
+	prefix = filepath.ToSlash(prefix)
+	if prefix == "." {
+		prefix = ""
+	} else {
+		prefix += "/"
+	}
+
+	hash, err := repository.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return err
+	}
+
+	commit, err := repository.CommitObject(*hash)
+	if err != nil {
+		return err
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return err
+	}
+
+	return tree.Files().ForEach(func(f *object.File) error {
+		if !strings.HasPrefix(f.Name, prefix) {
+			return nil
+		}
+
+		mode, err := f.Mode.ToOSFileMode()
+		if err != nil {
+			return err
+		}
+		if !mode.IsRegular() {
+			return nil
+		}
+
+		return exportFile(f, filepath.Join(dstPath, filepath.FromSlash(strings.TrimPrefix(f.Name, prefix))), mode)
+	})
+}
+
+func exportFile(f *object.File, path string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	r, err := f.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, r)
+	return err
+}
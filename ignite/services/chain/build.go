@@ -190,6 +190,9 @@ func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (build
 		gocmd.FlagMod, gocmd.FlagModValueReadOnly,
 		gocmd.FlagLdflags, gocmd.Ldflags(ldFlags...),
 	}
+	if c.options.trimPath {
+		buildFlags = append(buildFlags, gocmd.FlagTrimpath)
+	}
 
 	fmt.Fprintln(c.stdLog().out, "📦 Installing dependencies...")
 
@@ -85,6 +85,18 @@ type chainOptions struct {
 
 	// path of a custom config file
 	ConfigFile string
+
+	// host overwrites the addresses of the servers in the config when it is set.
+	host *chainconfig.Host
+
+	// faucetHost overwrites the address of the faucet server in the config when it is set.
+	faucetHost string
+
+	// accounts are added to the accounts of the config.
+	accounts []chainconfig.Account
+
+	// trimPath removes the file system paths from the built binaries.
+	trimPath bool
 }
 
 // Option configures Chain.
@@ -125,6 +137,27 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// Host overwrites the addresses of the servers started for the chain in the config.
+func Host(host chainconfig.Host) Option {
+	return func(c *Chain) {
+		c.options.host = &host
+	}
+}
+
+// FaucetHost overwrites the address of the faucet server of the chain in the config.
+func FaucetHost(host string) Option {
+	return func(c *Chain) {
+		c.options.faucetHost = host
+	}
+}
+
+// Accounts adds accounts to the accounts of the chain in the config.
+func Accounts(accounts ...chainconfig.Account) Option {
+	return func(c *Chain) {
+		c.options.accounts = append(c.options.accounts, accounts...)
+	}
+}
+
 // EnableThirdPartyModuleCodegen enables code generation for third party modules,
 // including the SDK.
 func EnableThirdPartyModuleCodegen() Option {
@@ -133,6 +166,14 @@ func EnableThirdPartyModuleCodegen() Option {
 	}
 }
 
+// TrimPath removes the file system paths from the built binaries so that the builds
+// of the same source are reproducible on other machines.
+func TrimPath() Option {
+	return func(c *Chain) {
+		c.options.trimPath = true
+	}
+}
+
 // New initializes a new Chain with options that its source lives at path.
 func New(path string, options ...Option) (*Chain, error) {
 	app, err := NewAppAt(path)
@@ -220,11 +261,30 @@ func (c *Chain) ConfigPath() string {
 
 // Config returns the config of the chain
 func (c *Chain) Config() (chainconfig.Config, error) {
-	configPath := c.ConfigPath()
-	if configPath == "" {
-		return chainconfig.DefaultConf, nil
+	conf := chainconfig.DefaultConf
+
+	if configPath := c.ConfigPath(); configPath != "" {
+		var err error
+		if conf, err = chainconfig.ParseFile(configPath); err != nil {
+			return chainconfig.Config{}, err
+		}
 	}
-	return chainconfig.ParseFile(configPath)
+
+	if c.options.host != nil {
+		conf.Host = *c.options.host
+	}
+
+	if c.options.faucetHost != "" {
+		conf.Faucet.Host = c.options.faucetHost
+		conf.Faucet.Port = 0
+	}
+
+	if len(c.options.accounts) > 0 {
+		// copy the accounts to not modify the ones of the default config.
+		conf.Accounts = append(append([]chainconfig.Account{}, conf.Accounts...), c.options.accounts...)
+	}
+
+	return conf, nil
 }
 
 // ID returns the chain's id.
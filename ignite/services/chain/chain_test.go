@@ -8,6 +8,8 @@ import (
 	"github.com/docker/docker/pkg/archive"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
 )
 
 func TestSourceVersion(t *testing.T) {
@@ -43,3 +45,38 @@ func tempSource(t *testing.T, tarPath string) (path string) {
 
 	return filepath.Join(dir, dirs[0].Name())
 }
+
+func TestConfigHost(t *testing.T) {
+	host := chainconfig.Host{
+		RPC:     "0.0.0.0:44001",
+		P2P:     "0.0.0.0:44002",
+		Prof:    "0.0.0.0:44003",
+		GRPC:    "0.0.0.0:44004",
+		GRPCWeb: "0.0.0.0:44005",
+		API:     "0.0.0.0:44006",
+	}
+
+	c, err := New(tempSource(t, "testdata/version/mars.v0.2.tar.gz"), Host(host), FaucetHost("0.0.0.0:44007"))
+	require.NoError(t, err)
+
+	conf, err := c.Config()
+	require.NoError(t, err)
+	require.Equal(t, host, conf.Host)
+	require.Equal(t, "0.0.0.0:44007", chainconfig.FaucetHost(conf))
+}
+
+func TestConfigAccounts(t *testing.T) {
+	account := chainconfig.Account{
+		Name:    "coordinator",
+		Address: "spn1ktjvl7j5y8z4x2l3c5dpr6a8m0qxmwj3ccq0hr",
+		Coins:   []string{"1000uspn"},
+	}
+
+	c, err := New(tempSource(t, "testdata/version/mars.v0.2.tar.gz"), Accounts(account))
+	require.NoError(t, err)
+
+	conf, err := c.Config()
+	require.NoError(t, err)
+	require.Contains(t, conf.Accounts, account)
+	require.Greater(t, len(conf.Accounts), 1)
+}
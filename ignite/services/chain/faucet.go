@@ -4,13 +4,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/pkg/errors"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
 	"github.com/ignite-hq/cli/ignite/pkg/xurl"
 )
 
@@ -26,6 +33,11 @@ var (
 	envAPIAddress = os.Getenv("API_ADDRESS")
 )
 
+// faucetLimitsFileName is the name of the file inside the chain's home that
+// keeps the transfers sent by the faucet. it is deleted with the chain's data
+// so the max amounts are refreshed when the chain is reset.
+const faucetLimitsFileName = "faucet/limits.db"
+
 // Faucet returns the faucet for the chain or an error if the faucet
 // configuration is wrong or not configured (not enabled) at all.
 func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
@@ -49,13 +61,19 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		return cosmosfaucet.Faucet{}, ErrFaucetIsNotEnabled
 	}
 
-	if _, err := commands.ShowAccount(ctx, *conf.Faucet.Name); err != nil {
+	account, err := commands.ShowAccount(ctx, *conf.Faucet.Name)
+	if err != nil {
 		if err == chaincmdrunner.ErrAccountDoesNotExist {
 			return cosmosfaucet.Faucet{}, ErrFaucetAccountDoesNotExist
 		}
 		return cosmosfaucet.Faucet{}, err
 	}
 
+	broadcaster, err := c.faucetBroadcaster(account.Address)
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
 	// construct faucet options.
 	apiAddress := conf.Host.API
 	if envAPIAddress != "" {
@@ -67,10 +85,54 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		return cosmosfaucet.Faucet{}, fmt.Errorf("invalid host api address format: %w", err)
 	}
 
+	home, err := c.Home()
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
+	limitStorage, err := cache.NewStorage(filepath.Join(home, faucetLimitsFileName))
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
 	faucetOptions := []cosmosfaucet.Option{
 		cosmosfaucet.Account(*conf.Faucet.Name, "", ""),
 		cosmosfaucet.ChainID(id),
 		cosmosfaucet.OpenAPI(apiAddress),
+		cosmosfaucet.Broadcaster(broadcaster),
+		cosmosfaucet.Limits(cosmosfaucet.NewCacheLimitStore(limitStorage)),
+	}
+
+	if conf.Faucet.RequestRateWindow != "" {
+		requestRateWindow, err := time.ParseDuration(conf.Faucet.RequestRateWindow)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, fmt.Errorf("%s: %s", err, conf.Faucet.RequestRateWindow)
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.RequestRateWindow(requestRateWindow))
+	}
+
+	faucetOptions = append(faucetOptions,
+		cosmosfaucet.IPRateLimit(conf.Faucet.IPRateLimit),
+		cosmosfaucet.GlobalRateLimit(conf.Faucet.GlobalRateLimit),
+		cosmosfaucet.AllowAddresses(conf.Faucet.AllowAddresses...),
+		cosmosfaucet.DenyAddresses(conf.Faucet.DenyAddresses...),
+		cosmosfaucet.AllowCIDRs(conf.Faucet.AllowCIDRs...),
+		cosmosfaucet.DenyCIDRs(conf.Faucet.DenyCIDRs...),
+		cosmosfaucet.ProofOfWork(conf.Faucet.PoWDifficulty),
+	)
+
+	if conf.Faucet.AccessLog != "" {
+		accessLog, err := c.openFaucetAccessLog(ctx, conf.Faucet.AccessLog)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, err
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.AccessLog(accessLog))
+	}
+
+	if conf.Faucet.AdminToken != "" {
+		faucetOptions = append(faucetOptions, cosmosfaucet.AdminToken(conf.Faucet.AdminToken))
 	}
 
 	// parse coins to pass to the faucet as coins.
@@ -109,3 +171,121 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 	// init the faucet with options and return.
 	return cosmosfaucet.New(ctx, commands, faucetOptions...)
 }
+
+// openFaucetAccessLog opens the access log file of the faucet at path relative to the app
+// for appending. the file is closed when ctx is canceled.
+func (c *Chain) openFaucetAccessLog(ctx context.Context, path string) (*os.File, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.app.Path, path)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		f.Close()
+	}()
+
+	return f, nil
+}
+
+// faucetBroadcaster returns a broadcaster that sends the faucet txs signed by
+// the account with accountAddress through the chain's node.
+func (c *Chain) faucetBroadcaster(accountAddress string) (*faucetBroadcaster, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAddress, err := xurl.HTTP(conf.Host.RPC)
+	if err != nil {
+		return nil, err
+	}
+
+	addressPrefix, err := cosmosutil.GetAddressPrefix(accountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &faucetBroadcaster{
+		options: []cosmosclient.Option{
+			cosmosclient.WithHome(home),
+			cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)),
+			cosmosclient.WithNodeAddress(nodeAddress),
+			cosmosclient.WithAddressPrefix(addressPrefix),
+		},
+	}, nil
+}
+
+// faucetBroadcaster broadcasts faucet txs in-process with a cosmos client.
+// the client is created on the first broadcast because the chain isn't
+// running yet when the faucet is created.
+type faucetBroadcaster struct {
+	mu      sync.Mutex
+	client  *cosmosclient.Client
+	options []cosmosclient.Option
+}
+
+// BroadcastTx implements cosmosfaucet.TxBroadcaster.
+func (b *faucetBroadcaster) BroadcastTx(ctx context.Context, accountName string, msgs ...sdk.Msg) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	client, err := b.clientLocked(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.BroadcastTx(accountName, msgs...)
+	return err
+}
+
+// Balances implements cosmosfaucet.BalanceQuerier.
+func (b *faucetBroadcaster) Balances(ctx context.Context, address string) (sdk.Coins, error) {
+	b.mu.Lock()
+	client, err := b.clientLocked(ctx)
+	b.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := banktypes.NewQueryClient(client.Context()).AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+		Address: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Balances, nil
+}
+
+// clientLocked returns the cosmos client, it creates the client on the first call.
+// b.mu must be held by the caller.
+func (b *faucetBroadcaster) clientLocked(ctx context.Context) (*cosmosclient.Client, error) {
+	if b.client == nil {
+		client, err := cosmosclient.New(ctx, b.options...)
+		if err != nil {
+			return nil, err
+		}
+		b.client = &client
+	}
+
+	return b.client, nil
+}
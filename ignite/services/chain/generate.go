@@ -106,6 +106,10 @@ func (c *Chain) Generate(
 		cosmosgen.IncludeDirs(conf.Build.Proto.ThirdPartyPaths),
 	}
 
+	if conf.Build.Proto.Workers > 0 {
+		options = append(options, cosmosgen.Workers(conf.Build.Proto.Workers))
+	}
+
 	if targetOptions.isGoEnabled {
 		options = append(options, cosmosgen.WithGoGeneration(c.app.ImportPath))
 	}
@@ -0,0 +1,94 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+)
+
+const (
+	// moduleDir is the dir of the app's modules.
+	moduleDir = "x"
+
+	// ibcModuleImplementation is the file that implements the IBC module interface
+	// in the modules scaffolded with IBC.
+	ibcModuleImplementation = "module_ibc.go"
+)
+
+// IBCModule is an IBC module scaffolded in the app.
+type IBCModule struct {
+	// PortID is the port that the module binds to.
+	PortID string
+
+	// Version is the version of the module's channels.
+	Version string
+}
+
+// IBCModules returns the IBC modules scaffolded in the app.
+// we naively check the existence of module_ibc.go in the modules, scaffolded
+// IBC modules bind to a port named after the module with version "<module>-1".
+func (c *Chain) IBCModules() ([]IBCModule, error) {
+	entries, err := os.ReadDir(filepath.Join(c.app.Path, moduleDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []IBCModule
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		_, err := os.Stat(filepath.Join(c.app.Path, moduleDir, entry.Name(), ibcModuleImplementation))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		modules = append(modules, IBCModule{
+			PortID:  entry.Name(),
+			Version: entry.Name() + "-1",
+		})
+	}
+
+	return modules, nil
+}
+
+// AddressPrefix returns the address prefix of the accounts of the chain.
+// the chain must be initialized.
+func (c *Chain) AddressPrefix(ctx context.Context) (string, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	if len(conf.Accounts) == 0 {
+		return "", errors.New("no accounts are configured for the chain")
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	account, err := commands.ShowAccount(ctx, conf.Accounts[0].Name)
+	if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+		return "", errors.New("the chain is not initialized")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return cosmosutil.GetAddressPrefix(account.Address)
+}
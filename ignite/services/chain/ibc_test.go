@@ -0,0 +1,30 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBCModules(t *testing.T) {
+	path := tempSource(t, "testdata/version/mars.v0.2.tar.gz")
+
+	for _, file := range []string{
+		"x/blog/module_ibc.go",
+		"x/blog/module.go",
+		"x/mars/module.go",
+	} {
+		file = filepath.Join(path, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+		require.NoError(t, os.WriteFile(file, []byte("package module\n"), 0644))
+	}
+
+	c, err := New(path)
+	require.NoError(t, err)
+
+	modules, err := c.IBCModules()
+	require.NoError(t, err)
+	require.Equal(t, []IBCModule{{PortID: "blog", Version: "blog-1"}}, modules)
+}
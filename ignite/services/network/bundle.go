@@ -0,0 +1,364 @@
+package network
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/pkg/events"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+// files of a launch bundle.
+const (
+	bundleFileLaunch             = "launch.json"
+	bundleFileGenesisInformation = "genesis_information.json"
+	bundleFileRequests           = "requests.json"
+	bundleFileInitialGenesis     = "initial_genesis.json"
+	bundleFileGenesis            = "genesis.json"
+	bundleFilePeers              = "peers.txt"
+)
+
+// LaunchBundle holds the information to prepare the genesis of a chain launch without SPN.
+type LaunchBundle struct {
+	ChainLaunch        networktypes.ChainLaunch
+	GenesisInformation networktypes.GenesisInformation
+	RewardsInfo        networktypes.Reward
+	SPNChainID         string
+	LastBlockHeight    int64
+	UnbondingTime      int64
+
+	// Requests are the approved requests of the launch.
+	Requests []networktypes.Request
+
+	// InitialGenesis is the initial genesis of a launch with a genesis URL.
+	InitialGenesis []byte
+
+	// Genesis is the final genesis of the launch.
+	Genesis []byte
+}
+
+// launchManifest is the content of the launch file of a bundle.
+type launchManifest struct {
+	ChainLaunch     networktypes.ChainLaunch `json:"chain_launch"`
+	RewardsInfo     networktypes.Reward      `json:"rewards_info"`
+	SPNChainID      string                   `json:"spn_chain_id"`
+	LastBlockHeight int64                    `json:"last_block_height"`
+	UnbondingTime   int64                    `json:"unbonding_time"`
+	GenesisHash     string                   `json:"genesis_hash"`
+}
+
+// bundleGenesisInformation is the genesis information in a bundle, the peers and the
+// content of requests are proto messages encoded in JSON.
+type bundleGenesisInformation struct {
+	GenesisAccounts   []networktypes.GenesisAccount `json:"genesis_accounts"`
+	VestingAccounts   []networktypes.VestingAccount `json:"vesting_accounts"`
+	GenesisValidators []bundleGenesisValidator      `json:"genesis_validators"`
+}
+
+type bundleGenesisValidator struct {
+	Address        string          `json:"address"`
+	Gentx          []byte          `json:"gentx"`
+	Peer           json.RawMessage `json:"peer"`
+	SelfDelegation sdk.Coin        `json:"self_delegation"`
+}
+
+type bundleRequest struct {
+	RequestID uint64          `json:"request_id"`
+	Creator   string          `json:"creator"`
+	CreatedAt string          `json:"created_at"`
+	Status    string          `json:"status"`
+	Content   json.RawMessage `json:"content"`
+}
+
+// bundleCodec encodes the proto messages of a bundle.
+var bundleCodec = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// LaunchBundle fetches the information of a chain launch from SPN to prepare its genesis,
+// the final genesis is not fetched and must be prepared from the bundle.
+func (n Network) LaunchBundle(ctx context.Context, launchID uint64) (LaunchBundle, error) {
+	chainLaunch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return LaunchBundle{}, err
+	}
+
+	gi, err := n.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return LaunchBundle{}, err
+	}
+
+	rewardsInfo, lastBlockHeight, unbondingTime, err := n.RewardsInfo(ctx, launchID, chainLaunch.ConsumerRevisionHeight)
+	if err != nil {
+		return LaunchBundle{}, err
+	}
+
+	spnChainID, err := n.ChainID(ctx)
+	if err != nil {
+		return LaunchBundle{}, err
+	}
+
+	requests, err := n.Requests(ctx, launchID)
+	if err != nil {
+		return LaunchBundle{}, err
+	}
+	approvedStatus := launchtypes.Request_Status_name[int32(launchtypes.Request_APPROVED)]
+	var approved []networktypes.Request
+	for _, request := range requests {
+		if request.Status == approvedStatus {
+			approved = append(approved, request)
+		}
+	}
+
+	b := LaunchBundle{
+		ChainLaunch:        chainLaunch,
+		GenesisInformation: gi,
+		RewardsInfo:        rewardsInfo,
+		SPNChainID:         spnChainID,
+		LastBlockHeight:    lastBlockHeight,
+		UnbondingTime:      unbondingTime,
+		Requests:           approved,
+	}
+
+	if chainLaunch.GenesisURL != "" {
+		n.ev.Send(events.New(events.StatusOngoing, "Fetching the initial genesis"))
+		genesis, hash, err := cosmosutil.GenesisAndHashFromURL(ctx, chainLaunch.GenesisURL)
+		if err != nil {
+			return LaunchBundle{}, err
+		}
+		if hash != chainLaunch.GenesisHash {
+			return LaunchBundle{}, fmt.Errorf(
+				"genesis from URL %s is invalid. expected hash %s, actual hash %s",
+				chainLaunch.GenesisURL,
+				chainLaunch.GenesisHash,
+				hash,
+			)
+		}
+		b.InitialGenesis = genesis
+		n.ev.Send(events.New(events.StatusDone, "Initial genesis fetched"))
+	}
+
+	return b, nil
+}
+
+// GenesisHash returns the hash of the final genesis of the bundle.
+func (b LaunchBundle) GenesisHash() string {
+	return cosmosutil.GenesisHash(b.Genesis)
+}
+
+// WriteBundle writes the bundle as a gzipped tarball to w.
+func WriteBundle(w io.Writer, b LaunchBundle) error {
+	files, err := b.files()
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    f.name,
+			Mode:    0644,
+			Size:    int64(len(f.data)),
+			ModTime: time.Unix(b.ChainLaunch.LaunchTime, 0),
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// ReadBundle reads a bundle written by WriteBundle from r and checks the hash of its genesis.
+func ReadBundle(r io.Reader) (LaunchBundle, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return LaunchBundle{}, errors.Wrap(err, "invalid launch bundle")
+	}
+	defer gr.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return LaunchBundle{}, errors.Wrap(err, "invalid launch bundle")
+		}
+		if files[header.Name], err = io.ReadAll(tr); err != nil {
+			return LaunchBundle{}, err
+		}
+	}
+
+	for _, name := range []string{bundleFileLaunch, bundleFileGenesisInformation, bundleFileRequests, bundleFileGenesis} {
+		if _, ok := files[name]; !ok {
+			return LaunchBundle{}, fmt.Errorf("the launch bundle has no %s file", name)
+		}
+	}
+
+	var manifest launchManifest
+	if err := json.Unmarshal(files[bundleFileLaunch], &manifest); err != nil {
+		return LaunchBundle{}, errors.Wrapf(err, "invalid %s", bundleFileLaunch)
+	}
+
+	b := LaunchBundle{
+		ChainLaunch:     manifest.ChainLaunch,
+		RewardsInfo:     manifest.RewardsInfo,
+		SPNChainID:      manifest.SPNChainID,
+		LastBlockHeight: manifest.LastBlockHeight,
+		UnbondingTime:   manifest.UnbondingTime,
+		InitialGenesis:  files[bundleFileInitialGenesis],
+		Genesis:         files[bundleFileGenesis],
+	}
+
+	if hash := b.GenesisHash(); hash != manifest.GenesisHash {
+		return LaunchBundle{}, fmt.Errorf("the genesis of the bundle has the hash %s, expected %s", hash, manifest.GenesisHash)
+	}
+
+	var gi bundleGenesisInformation
+	if err := json.Unmarshal(files[bundleFileGenesisInformation], &gi); err != nil {
+		return LaunchBundle{}, errors.Wrapf(err, "invalid %s", bundleFileGenesisInformation)
+	}
+	b.GenesisInformation.GenesisAccounts = gi.GenesisAccounts
+	b.GenesisInformation.VestingAccounts = gi.VestingAccounts
+	for _, val := range gi.GenesisValidators {
+		var peer launchtypes.Peer
+		if err := bundleCodec.UnmarshalJSON(val.Peer, &peer); err != nil {
+			return LaunchBundle{}, errors.Wrapf(err, "invalid peer of validator %s", val.Address)
+		}
+		b.GenesisInformation.GenesisValidators = append(b.GenesisInformation.GenesisValidators, networktypes.GenesisValidator{
+			Address:        val.Address,
+			Gentx:          val.Gentx,
+			Peer:           peer,
+			SelfDelegation: val.SelfDelegation,
+		})
+	}
+
+	var requests []bundleRequest
+	if err := json.Unmarshal(files[bundleFileRequests], &requests); err != nil {
+		return LaunchBundle{}, errors.Wrapf(err, "invalid %s", bundleFileRequests)
+	}
+	for _, request := range requests {
+		var content launchtypes.RequestContent
+		if err := bundleCodec.UnmarshalJSON(request.Content, &content); err != nil {
+			return LaunchBundle{}, errors.Wrapf(err, "invalid content of request %d", request.RequestID)
+		}
+		b.Requests = append(b.Requests, networktypes.Request{
+			LaunchID:  b.ChainLaunch.ID,
+			RequestID: request.RequestID,
+			Creator:   request.Creator,
+			CreatedAt: request.CreatedAt,
+			Content:   content,
+			Status:    request.Status,
+		})
+	}
+
+	return b, nil
+}
+
+type bundleFile struct {
+	name string
+	data []byte
+}
+
+// files returns the files of the bundle.
+func (b LaunchBundle) files() ([]bundleFile, error) {
+	if b.Genesis == nil {
+		return nil, errors.New("the launch bundle has no genesis")
+	}
+
+	manifest, err := json.MarshalIndent(launchManifest{
+		ChainLaunch:     b.ChainLaunch,
+		RewardsInfo:     b.RewardsInfo,
+		SPNChainID:      b.SPNChainID,
+		LastBlockHeight: b.LastBlockHeight,
+		UnbondingTime:   b.UnbondingTime,
+		GenesisHash:     b.GenesisHash(),
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	gi := bundleGenesisInformation{
+		GenesisAccounts:   b.GenesisInformation.GenesisAccounts,
+		VestingAccounts:   b.GenesisInformation.VestingAccounts,
+		GenesisValidators: []bundleGenesisValidator{},
+	}
+	var peers []string
+	for _, val := range b.GenesisInformation.GenesisValidators {
+		peer, err := bundleCodec.MarshalJSON(&val.Peer)
+		if err != nil {
+			return nil, err
+		}
+		gi.GenesisValidators = append(gi.GenesisValidators, bundleGenesisValidator{
+			Address:        val.Address,
+			Gentx:          val.Gentx,
+			Peer:           peer,
+			SelfDelegation: val.SelfDelegation,
+		})
+
+		peerAddress, err := PeerAddress(val.Peer)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, peerAddress)
+	}
+	genesisInformation, err := json.MarshalIndent(gi, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	requests := []bundleRequest{}
+	for _, request := range b.Requests {
+		content, err := bundleCodec.MarshalJSON(&request.Content)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, bundleRequest{
+			RequestID: request.RequestID,
+			Creator:   request.Creator,
+			CreatedAt: request.CreatedAt,
+			Status:    request.Status,
+			Content:   content,
+		})
+	}
+	requestsData, err := json.MarshalIndent(requests, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	files := []bundleFile{
+		{bundleFileLaunch, manifest},
+		{bundleFileGenesisInformation, genesisInformation},
+		{bundleFileRequests, requestsData},
+	}
+	if b.InitialGenesis != nil {
+		files = append(files, bundleFile{bundleFileInitialGenesis, b.InitialGenesis})
+	}
+	files = append(files,
+		bundleFile{bundleFileGenesis, b.Genesis},
+		bundleFile{bundleFilePeers, []byte(strings.Join(peers, ",") + "\n")},
+	)
+
+	return files, nil
+}
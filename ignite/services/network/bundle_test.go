@@ -0,0 +1,93 @@
+package network
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+func TestLaunchBundle(t *testing.T) {
+	initialGenesis := []byte(`{"chain_id": "earth-1"}`)
+	b := LaunchBundle{
+		ChainLaunch: networktypes.ChainLaunch{
+			ID:              1,
+			ChainID:         "earth-1",
+			SourceURL:       "https://github.com/ignite-hq/example",
+			SourceHash:      "1234567",
+			GenesisURL:      "https://example.com/genesis.json",
+			GenesisHash:     cosmosutil.GenesisHash(initialGenesis),
+			LaunchTime:      1654000000,
+			LaunchTriggered: true,
+		},
+		GenesisInformation: networktypes.GenesisInformation{
+			GenesisAccounts: []networktypes.GenesisAccount{
+				{Address: "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g", Coins: "1000stake"},
+			},
+			VestingAccounts: []networktypes.VestingAccount{
+				{Address: "spn1mmlqwyqk7neqegffp99q86eckpm4pjahzz39ps", TotalBalance: "100stake", Vesting: "50stake", EndTime: 1655000000},
+			},
+			GenesisValidators: []networktypes.GenesisValidator{
+				{
+					Address:        "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+					Gentx:          []byte(`{"body": {}}`),
+					Peer:           launchtypes.NewPeerConn("nodeid1", "200.100.50.20:26656"),
+					SelfDelegation: sdk.NewInt64Coin("stake", 100),
+				},
+				{
+					Address:        "spn1mmlqwyqk7neqegffp99q86eckpm4pjahzz39ps",
+					Gentx:          []byte(`{"body": {}}`),
+					Peer:           launchtypes.NewPeerTunnel("nodeid2", "tunnel", "https://tunnel.example.com"),
+					SelfDelegation: sdk.NewInt64Coin("stake", 50),
+				},
+			},
+		},
+		SPNChainID:      "spn-1",
+		LastBlockHeight: 10,
+		UnbondingTime:   1814400,
+		Requests: []networktypes.Request{
+			{
+				LaunchID:  1,
+				RequestID: 2,
+				Creator:   "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+				CreatedAt: "2022-06-01T00:00:00Z",
+				Content: launchtypes.NewGenesisAccount(
+					1,
+					"spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+					sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+				),
+				Status: "APPROVED",
+			},
+			{
+				LaunchID:  1,
+				RequestID: 3,
+				Creator:   "spn1mmlqwyqk7neqegffp99q86eckpm4pjahzz39ps",
+				CreatedAt: "2022-06-01T00:00:00Z",
+				Content:   launchtypes.NewAccountRemoval("spn1mmlqwyqk7neqegffp99q86eckpm4pjahzz39ps"),
+				Status:    "APPROVED",
+			},
+		},
+		InitialGenesis: initialGenesis,
+		Genesis:        []byte(`{"chain_id": "earth-1", "genesis_time": "2022-05-31T12:26:40Z"}`),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundle(&buf, b))
+
+	got, err := ReadBundle(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, b, got)
+
+	// the genesis of a bundle must be provided
+	b.Genesis = nil
+	require.EqualError(t, WriteBundle(&buf, b), "the launch bundle has no genesis")
+
+	// a bundle that is not a gzipped tarball is invalid
+	_, err = ReadBundle(bytes.NewReader([]byte("launch")))
+	require.Error(t, err)
+}
@@ -124,7 +124,13 @@ func (n Network) InitializeMainnet(
 		return 0, err
 	}
 
-	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Campaign %d initialized on mainnet", campaignID)))
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Campaign %d initialized on mainnet", campaignID),
+		events.Payload(networktypes.CampaignPayload{
+			CampaignID: campaignID,
+			MainnetID:  initMainnetRes.MainnetID,
+		}),
+	))
 
 	return initMainnetRes.MainnetID, nil
 }
@@ -162,8 +168,194 @@ func (n Network) UpdateCampaign(
 	if _, err := n.cosmos.BroadcastTx(n.account.Name, msgs...); err != nil {
 		return err
 	}
-	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
-		"Campaign %d updated", id,
-	)))
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Campaign %d updated", id),
+		events.Payload(networktypes.CampaignPayload{CampaignID: id}),
+	))
 	return nil
 }
+
+// MintVouchers mints the vouchers of campaign shares to the coordinator of the campaign.
+func (n Network) MintVouchers(ctx context.Context, campaignID uint64, shares campaigntypes.Shares) error {
+	res, err := n.campaignQuery.Campaign(ctx, &campaigntypes.QueryGetCampaignRequest{
+		CampaignID: campaignID,
+	})
+	if err != nil {
+		return err
+	}
+	totalShares, err := n.TotalShares(ctx)
+	if err != nil {
+		return err
+	}
+	if err := CheckMintShares(res.Campaign.AllocatedShares, shares, totalShares); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Minting vouchers for the campaign %d", campaignID)))
+	msg := campaigntypes.NewMsgMintVouchers(
+		n.account.Address(networktypes.SPN),
+		campaignID,
+		shares,
+	)
+	if _, err := n.cosmos.BroadcastTx(n.account.Name, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Vouchers minted for the shares %s", shares),
+		events.Payload(networktypes.CampaignPayload{CampaignID: campaignID}),
+	))
+	return nil
+}
+
+// BurnVouchers burns campaign vouchers of the account and deallocates their shares.
+func (n Network) BurnVouchers(ctx context.Context, campaignID uint64, vouchers sdk.Coins) error {
+	if err := n.checkVouchersBalance(ctx, campaignID, vouchers); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Burning vouchers of the campaign %d", campaignID)))
+	msg := campaigntypes.NewMsgBurnVouchers(
+		n.account.Address(networktypes.SPN),
+		campaignID,
+		vouchers,
+	)
+	if _, err := n.cosmos.BroadcastTx(n.account.Name, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Vouchers %s burned", vouchers),
+		events.Payload(networktypes.CampaignPayload{CampaignID: campaignID}),
+	))
+	return nil
+}
+
+// RedeemVouchers redeems campaign vouchers of the account into shares of a mainnet account,
+// the shares are redeemed to the account itself when no mainnet account is provided.
+func (n Network) RedeemVouchers(ctx context.Context, campaignID uint64, account string, vouchers sdk.Coins) error {
+	if account == "" {
+		account = n.account.Address(networktypes.SPN)
+	}
+	if err := n.checkMainnetNotLaunched(ctx, campaignID); err != nil {
+		return err
+	}
+	if err := n.checkVouchersBalance(ctx, campaignID, vouchers); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Redeeming vouchers of the campaign %d", campaignID)))
+	msg := campaigntypes.NewMsgRedeemVouchers(
+		n.account.Address(networktypes.SPN),
+		account,
+		campaignID,
+		vouchers,
+	)
+	if _, err := n.cosmos.BroadcastTx(n.account.Name, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Vouchers %s redeemed to the mainnet account %s", vouchers, account),
+		events.Payload(networktypes.CampaignPayload{CampaignID: campaignID}),
+	))
+	return nil
+}
+
+// UnredeemVouchers converts shares of the mainnet account back into campaign vouchers.
+func (n Network) UnredeemVouchers(ctx context.Context, campaignID uint64, shares campaigntypes.Shares) error {
+	if err := n.checkMainnetNotLaunched(ctx, campaignID); err != nil {
+		return err
+	}
+
+	address := n.account.Address(networktypes.SPN)
+	acc, err := n.MainnetAccount(ctx, campaignID, address)
+	if err == ErrObjectNotFound {
+		return fmt.Errorf("%s has no mainnet account for the campaign %d", address, campaignID)
+	} else if err != nil {
+		return err
+	}
+	if err := CheckUnredeemShares(acc.Shares, shares); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Unredeeming shares of the campaign %d", campaignID)))
+	msg := campaigntypes.NewMsgUnredeemVouchers(
+		address,
+		campaignID,
+		shares,
+	)
+	if _, err := n.cosmos.BroadcastTx(n.account.Name, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Shares %s unredeemed", shares),
+		events.Payload(networktypes.CampaignPayload{CampaignID: campaignID}),
+	))
+	return nil
+}
+
+// checkVouchersBalance checks that the account has the vouchers of the campaign.
+func (n Network) checkVouchersBalance(ctx context.Context, campaignID uint64, vouchers sdk.Coins) error {
+	balance, err := n.CampaignVouchers(ctx, campaignID, n.account.Address(networktypes.SPN))
+	if err != nil {
+		return err
+	}
+	return CheckVouchersBalance(balance, vouchers, campaignID)
+}
+
+// checkMainnetNotLaunched checks that the launch of the mainnet of the campaign is not triggered,
+// the shares of the mainnet accounts can no longer change once it is.
+func (n Network) checkMainnetNotLaunched(ctx context.Context, campaignID uint64) error {
+	campaign, err := n.Campaign(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	if !campaign.MainnetInitialized {
+		return nil
+	}
+	mainnet, err := n.ChainLaunch(ctx, campaign.MainnetID)
+	if err != nil {
+		return err
+	}
+	if mainnet.LaunchTriggered {
+		return fmt.Errorf("the launch of the mainnet %d of the campaign %d is triggered", campaign.MainnetID, campaignID)
+	}
+	return nil
+}
+
+// CampaignAccount fetches the mainnet shares and the vouchers of an account in a campaign,
+// the account of the network is used when no address is provided.
+func (n Network) CampaignAccount(ctx context.Context, campaignID uint64, address string) (networktypes.CampaignAccount, error) {
+	if address == "" {
+		address = n.account.Address(networktypes.SPN)
+	}
+	acc := networktypes.CampaignAccount{Address: address}
+
+	mainnetAcc, err := n.MainnetAccount(ctx, campaignID, address)
+	if err != nil && err != ErrObjectNotFound {
+		return acc, err
+	}
+	acc.Shares = mainnetAcc.Shares
+
+	if acc.Vouchers, err = n.CampaignVouchers(ctx, campaignID, address); err != nil {
+		return acc, err
+	}
+
+	if !acc.Shares.Empty() {
+		campaign, err := n.Campaign(ctx, campaignID)
+		if err != nil {
+			return acc, err
+		}
+		totalShares, err := n.TotalShares(ctx)
+		if err != nil {
+			return acc, err
+		}
+		if acc.Coins, err = acc.Shares.CoinsFromTotalSupply(campaign.TotalSupply, totalShares); err != nil {
+			return acc, err
+		}
+	}
+
+	return acc, nil
+}
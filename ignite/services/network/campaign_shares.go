@@ -0,0 +1,123 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+)
+
+// ParseShares parses campaign shares from a list of amounts or percentages of the total shares
+// format: 1000foo,12.5%bar,s/baz
+func ParseShares(str string, totalShares uint64) (campaigntypes.Shares, error) {
+	var coins sdk.Coins
+	for _, raw := range strings.Split(str, ",") {
+		raw = strings.TrimSpace(raw)
+
+		var (
+			coin sdk.Coin
+			err  error
+		)
+		if strings.Contains(raw, "%") {
+			percent, err := SharePercentFromString(raw)
+			if err != nil {
+				return nil, err
+			}
+			if coin, err = percent.Share(totalShares); err != nil {
+				return nil, err
+			}
+		} else if coin, err = sdk.ParseCoinNormalized(raw); err != nil {
+			return nil, err
+		}
+
+		coin.Denom = strings.TrimPrefix(coin.Denom, campaigntypes.SharePrefix)
+		if !coin.IsPositive() {
+			return nil, fmt.Errorf("%s share must be positive", coin.Denom)
+		}
+		coins = coins.Add(coin)
+	}
+
+	return campaigntypes.NewSharesFromCoins(coins), nil
+}
+
+// ParseVouchers parses the vouchers of a campaign from a list of coins, the voucher prefix of
+// the campaign is added to the denoms without it
+// format: 1000foo,v/1/bar
+func ParseVouchers(str string, campaignID uint64) (sdk.Coins, error) {
+	coins, err := sdk.ParseCoinsNormalized(str)
+	if err != nil {
+		return nil, err
+	}
+	if coins.Empty() {
+		return nil, fmt.Errorf("no vouchers in %q", str)
+	}
+
+	vouchers := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		if !strings.HasPrefix(coin.Denom, campaigntypes.VoucherPrefix) {
+			coin.Denom = campaigntypes.VoucherDenom(campaignID, coin.Denom)
+		}
+		vouchers = append(vouchers, coin)
+	}
+	vouchers = vouchers.Sort()
+
+	if err := campaigntypes.CheckVouchers(vouchers, campaignID); err != nil {
+		return nil, err
+	}
+	return vouchers, nil
+}
+
+// CheckMintShares checks that the shares can be allocated to the campaign without
+// allocating more than the total shares.
+func CheckMintShares(allocated, shares campaigntypes.Shares, totalShares uint64) error {
+	if shares.Empty() {
+		return fmt.Errorf("no shares to mint")
+	}
+	if err := campaigntypes.CheckShares(shares); err != nil {
+		return err
+	}
+
+	total := campaigntypes.IncreaseShares(allocated, shares)
+	for _, share := range shares {
+		if amount := total.AmountOf(share.Denom); uint64(amount) > totalShares {
+			return fmt.Errorf(
+				"cannot mint %s, %d of the %d shares of %s are already allocated",
+				share,
+				allocated.AmountOf(share.Denom),
+				totalShares,
+				share.Denom,
+			)
+		}
+	}
+	return nil
+}
+
+// CheckVouchersBalance checks that the balance has enough vouchers of the campaign.
+func CheckVouchersBalance(balance, vouchers sdk.Coins, campaignID uint64) error {
+	if err := campaigntypes.CheckVouchers(vouchers, campaignID); err != nil {
+		return err
+	}
+	for _, voucher := range vouchers {
+		if available := balance.AmountOf(voucher.Denom); available.LT(voucher.Amount) {
+			return fmt.Errorf("insufficient vouchers, %s is needed but the balance is %s%s", voucher, available, voucher.Denom)
+		}
+	}
+	return nil
+}
+
+// CheckUnredeemShares checks that the shares can be removed from the shares of a mainnet account.
+func CheckUnredeemShares(accountShares, shares campaigntypes.Shares) error {
+	if shares.Empty() {
+		return fmt.Errorf("no shares to unredeem")
+	}
+	if err := campaigntypes.CheckShares(shares); err != nil {
+		return err
+	}
+	for _, share := range shares {
+		if available := accountShares.AmountOf(share.Denom); available < share.Amount.Int64() {
+			return fmt.Errorf("cannot unredeem %s, the account has %d%s", share, available, share.Denom)
+		}
+	}
+	return nil
+}
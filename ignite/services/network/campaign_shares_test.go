@@ -0,0 +1,129 @@
+package network
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+)
+
+func newShares(t *testing.T, str string) campaigntypes.Shares {
+	shares, err := campaigntypes.NewShares(str)
+	require.NoError(t, err)
+	return shares
+}
+
+func TestParseShares(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want campaigntypes.Shares
+		err  string
+	}{
+		{
+			name: "amounts",
+			str:  "1000foo,500bar",
+			want: newShares(t, "500bar,1000foo"),
+		},
+		{
+			name: "amounts with the share prefix",
+			str:  "1000s/foo",
+			want: newShares(t, "1000foo"),
+		},
+		{
+			name: "percentages",
+			str:  "12.5%foo, 50%bar",
+			want: newShares(t, "5000bar,1250foo"),
+		},
+		{
+			name: "same denom",
+			str:  "1000foo,10%foo",
+			want: newShares(t, "2000foo"),
+		},
+		{
+			name: "non integer percentage",
+			str:  "0.001%foo",
+			err:  "foo share from total 10000 is not integer: 0.100000",
+		},
+		{
+			name: "zero share",
+			str:  "0foo",
+			err:  "foo share must be positive",
+		},
+		{
+			name: "invalid share",
+			str:  "foo",
+			err:  "invalid decimal coin expression: foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseShares(tt.str, 10000)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseVouchers(t *testing.T) {
+	got, err := ParseVouchers("1000foo,500v/1/bar", 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("v/1/foo", 1000),
+		sdk.NewInt64Coin("v/1/bar", 500),
+	), got)
+
+	_, err = ParseVouchers("500v/2/bar", 1)
+	require.EqualError(t, err, "v/2/bar doesn't contain the voucher prefix v/1/")
+
+	_, err = ParseVouchers("", 1)
+	require.Error(t, err)
+}
+
+func TestCheckMintShares(t *testing.T) {
+	allocated := newShares(t, "6000foo,1000bar")
+
+	require.NoError(t, CheckMintShares(allocated, newShares(t, "4000foo,9000bar,10000baz"), 10000))
+	require.NoError(t, CheckMintShares(nil, newShares(t, "10000foo"), 10000))
+	require.EqualError(t,
+		CheckMintShares(allocated, newShares(t, "4001foo"), 10000),
+		"cannot mint 4001s/foo, 6000 of the 10000 shares of s/foo are already allocated",
+	)
+	require.EqualError(t, CheckMintShares(allocated, nil, 10000), "no shares to mint")
+	require.Error(t, CheckMintShares(allocated, campaigntypes.Shares(sdk.NewCoins(sdk.NewInt64Coin("foo", 1))), 10000))
+}
+
+func TestCheckVouchersBalance(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewInt64Coin("v/1/foo", 1000), sdk.NewInt64Coin("v/1/bar", 500))
+
+	require.NoError(t, CheckVouchersBalance(balance, sdk.NewCoins(sdk.NewInt64Coin("v/1/foo", 1000)), 1))
+	require.EqualError(t,
+		CheckVouchersBalance(balance, sdk.NewCoins(sdk.NewInt64Coin("v/1/bar", 501)), 1),
+		"insufficient vouchers, 501v/1/bar is needed but the balance is 500v/1/bar",
+	)
+	require.EqualError(t,
+		CheckVouchersBalance(balance, sdk.NewCoins(sdk.NewInt64Coin("v/1/baz", 1)), 1),
+		"insufficient vouchers, 1v/1/baz is needed but the balance is 0v/1/baz",
+	)
+	require.Error(t, CheckVouchersBalance(balance, sdk.NewCoins(sdk.NewInt64Coin("v/2/foo", 1)), 1))
+}
+
+func TestCheckUnredeemShares(t *testing.T) {
+	accountShares := newShares(t, "1000foo")
+
+	require.NoError(t, CheckUnredeemShares(accountShares, newShares(t, "1000foo")))
+	require.EqualError(t,
+		CheckUnredeemShares(accountShares, newShares(t, "1001foo")),
+		"cannot unredeem 1001s/foo, the account has 1000s/foo",
+	)
+	require.EqualError(t,
+		CheckUnredeemShares(accountShares, newShares(t, "1bar")),
+		"cannot unredeem 1s/bar, the account has 0s/bar",
+	)
+	require.EqualError(t, CheckUnredeemShares(accountShares, nil), "no shares to unredeem")
+}
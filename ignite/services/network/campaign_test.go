@@ -0,0 +1,164 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+	"github.com/ignite-hq/cli/ignite/services/network/testutil"
+)
+
+func TestMintVouchers(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		shares         = newShares(t, "4000foo")
+	)
+
+	suite.CampaignQueryMock.
+		On(
+			"Campaign",
+			context.Background(),
+			&campaigntypes.QueryGetCampaignRequest{
+				CampaignID: testutil.CampaignID,
+			},
+		).
+		Return(&campaigntypes.QueryGetCampaignResponse{
+			Campaign: campaigntypes.Campaign{
+				CampaignID:      testutil.CampaignID,
+				AllocatedShares: newShares(t, "6000foo"),
+			},
+		}, nil).
+		Twice()
+	suite.CampaignQueryMock.
+		On(
+			"TotalShares",
+			context.Background(),
+			&campaigntypes.QueryTotalSharesRequest{},
+		).
+		Return(&campaigntypes.QueryTotalSharesResponse{
+			TotalShares: 10000,
+		}, nil).
+		Twice()
+	suite.CosmosClientMock.
+		On(
+			"BroadcastTx",
+			account.Name,
+			campaigntypes.NewMsgMintVouchers(
+				account.Address(networktypes.SPN),
+				testutil.CampaignID,
+				shares,
+			),
+		).
+		Return(testutil.NewResponse(&campaigntypes.MsgMintVouchersResponse{}), nil).
+		Once()
+
+	require.NoError(t, network.MintVouchers(context.Background(), testutil.CampaignID, shares))
+
+	// the total shares are exceeded, no transaction is broadcasted
+	err := network.MintVouchers(context.Background(), testutil.CampaignID, newShares(t, "4001foo"))
+	require.EqualError(t, err, "cannot mint 4001s/foo, 6000 of the 10000 shares of s/foo are already allocated")
+
+	suite.AssertAllMocks(t)
+}
+
+func TestCampaignAccount(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		address        = account.Address(networktypes.SPN)
+		voucherDenom   = campaigntypes.VoucherDenom(testutil.CampaignID, "foo")
+		otherVoucher   = campaigntypes.VoucherDenom(testutil.CampaignID+1, "foo")
+	)
+
+	suite.CampaignQueryMock.
+		On(
+			"MainnetAccount",
+			context.Background(),
+			&campaigntypes.QueryGetMainnetAccountRequest{
+				CampaignID: testutil.CampaignID,
+				Address:    address,
+			},
+		).
+		Return(&campaigntypes.QueryGetMainnetAccountResponse{
+			MainnetAccount: campaigntypes.MainnetAccount{
+				CampaignID: testutil.CampaignID,
+				Address:    address,
+				Shares:     newShares(t, "4000foo"),
+			},
+		}, nil).
+		Once()
+
+	// the balances are fetched in two pages.
+	suite.BankClient.
+		On(
+			"AllBalances",
+			context.Background(),
+			&banktypes.QueryAllBalancesRequest{
+				Address:    address,
+				Pagination: &query.PageRequest{},
+			},
+		).
+		Return(&banktypes.QueryAllBalancesResponse{
+			Balances:   sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin(otherVoucher, 5)),
+			Pagination: &query.PageResponse{NextKey: []byte("next")},
+		}, nil).
+		Once()
+	suite.BankClient.
+		On(
+			"AllBalances",
+			context.Background(),
+			&banktypes.QueryAllBalancesRequest{
+				Address:    address,
+				Pagination: &query.PageRequest{Key: []byte("next")},
+			},
+		).
+		Return(&banktypes.QueryAllBalancesResponse{
+			Balances:   sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 20)),
+			Pagination: &query.PageResponse{},
+		}, nil).
+		Once()
+
+	suite.CampaignQueryMock.
+		On(
+			"Campaign",
+			context.Background(),
+			&campaigntypes.QueryGetCampaignRequest{
+				CampaignID: testutil.CampaignID,
+			},
+		).
+		Return(&campaigntypes.QueryGetCampaignResponse{
+			Campaign: campaigntypes.Campaign{
+				CampaignID:  testutil.CampaignID,
+				TotalSupply: sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)),
+			},
+		}, nil).
+		Once()
+	suite.CampaignQueryMock.
+		On(
+			"TotalShares",
+			context.Background(),
+			&campaigntypes.QueryTotalSharesRequest{},
+		).
+		Return(&campaigntypes.QueryTotalSharesResponse{
+			TotalShares: 10000,
+		}, nil).
+		Once()
+
+	acc, err := network.CampaignAccount(context.Background(), testutil.CampaignID, "")
+	require.NoError(t, err)
+	require.Equal(t, networktypes.CampaignAccount{
+		Address:  address,
+		Shares:   newShares(t, "4000foo"),
+		Vouchers: sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 20)),
+		Coins:    sdk.NewCoins(sdk.NewInt64Coin("foo", 400)),
+	}, acc)
+
+	suite.AssertAllMocks(t)
+}
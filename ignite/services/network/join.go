@@ -183,22 +183,19 @@ func (n Network) sendValidatorRequest(
 
 	n.ev.Send(events.New(events.StatusOngoing, "Broadcasting validator transaction"))
 
-	res, err := n.cosmos.BroadcastTx(n.account.Name, msg)
+	requestPayload, err := n.sendRequestMsg(launchID, msg, &launchtypes.MsgRequestAddValidatorResponse{})
 	if err != nil {
 		return err
 	}
 
-	var requestRes launchtypes.MsgRequestAddValidatorResponse
-	if err := res.Decode(&requestRes); err != nil {
-		return err
-	}
-
-	if requestRes.AutoApproved {
-		n.ev.Send(events.New(events.StatusDone, "Validator added to the network by the coordinator!"))
+	payload := events.Payload(requestPayload)
+	if requestPayload.AutoApproved {
+		n.ev.Send(events.New(events.StatusDone, "Validator added to the network by the coordinator!", payload))
 	} else {
 		n.ev.Send(events.New(events.StatusDone,
 			fmt.Sprintf("Request %d to join the network as a validator has been submitted!",
-				requestRes.RequestID),
+				requestPayload.RequestID),
+			payload,
 		))
 	}
 	return nil
@@ -62,6 +62,10 @@ func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, remainingTi
 
 	n.ev.Send(events.New(events.StatusDone,
 		fmt.Sprintf("Chain %d will be launched on %s", launchID, xtime.NowAfter(remainingTime)),
+		events.Payload(networktypes.LaunchPayload{
+			LaunchID:   launchID,
+			LaunchTime: time.Now().Add(remainingTime).Unix(),
+		}),
 	))
 	return nil
 }
@@ -79,6 +83,7 @@ func (n Network) RevertLaunch(launchID uint64, chain Chain) error {
 
 	n.ev.Send(events.New(events.StatusDone,
 		fmt.Sprintf("Chain %d launch was reverted", launchID),
+		events.Payload(networktypes.LaunchPayload{LaunchID: launchID, Reverted: true}),
 	))
 
 	n.ev.Send(events.New(events.StatusOngoing, "Resetting the genesis time"))
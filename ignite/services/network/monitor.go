@@ -0,0 +1,245 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+type (
+	// BlockSignatures are the signatures of a block with the voting power of its validator set,
+	// validators are identified by their consensus address in hex.
+	BlockSignatures struct {
+		Height      int64
+		Time        time.Time
+		Signers     []string
+		VotingPower map[string]int64
+	}
+
+	// ChainMonitor follows the signatures of the genesis validators of a launched chain.
+	ChainMonitor struct {
+		validators      []monitoredValidator
+		height          int64
+		time            time.Time
+		blocks          uint64
+		missed          map[string]uint64
+		lastBlock       BlockSignatures
+		signatureCounts spntypes.SignatureCounts
+	}
+
+	monitoredValidator struct {
+		address         string
+		operatorAddress string
+		consAddress     string
+	}
+
+	// MonitorStatus is the status of a launched chain from the monitored blocks.
+	MonitorStatus struct {
+		Height int64     `json:"height"`
+		Time   time.Time `json:"time"`
+		// Blocks is the number of monitored blocks.
+		Blocks uint64 `json:"blocks"`
+		// OnlineVotingPower is the percentage of the voting power that signed the last block.
+		OnlineVotingPower float64                   `json:"online_voting_power"`
+		Validators        []MonitorValidatorStatus  `json:"validators"`
+		MonitoringPacket  spntypes.MonitoringPacket `json:"monitoring_packet"`
+	}
+
+	// MonitorValidatorStatus is the status of a genesis validator from the monitored blocks.
+	MonitorValidatorStatus struct {
+		Address         string `json:"address"`
+		OperatorAddress string `json:"operator_address"`
+		Signing         bool   `json:"signing"`
+		MissedBlocks    uint64 `json:"missed_blocks"`
+		VotingPower     int64  `json:"voting_power"`
+	}
+)
+
+// NewChainMonitor creates a monitor for the genesis validators of a chain.
+func NewChainMonitor(genVals []networktypes.GenesisValidator) (*ChainMonitor, error) {
+	m := &ChainMonitor{
+		missed:          make(map[string]uint64),
+		signatureCounts: spntypes.NewSignatureCounts(),
+	}
+
+	for _, val := range genVals {
+		info, _, err := cosmosutil.ParseGentx(val.Gentx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the gentx of %s: %w", val.Address, err)
+		}
+
+		var gentx cosmosutil.StargateGentx
+		if err := json.Unmarshal(val.Gentx, &gentx); err != nil {
+			return nil, err
+		}
+
+		m.validators = append(m.validators, monitoredValidator{
+			address:         val.Address,
+			operatorAddress: gentx.Body.Messages[0].ValidatorAddress,
+			consAddress:     ed25519.PubKey(info.PubKey).Address().String(),
+		})
+	}
+
+	return m, nil
+}
+
+// Height returns the height of the last monitored block, zero when no block is monitored.
+func (m *ChainMonitor) Height() int64 {
+	return m.height
+}
+
+// AddBlock adds the signatures of a block, the blocks must be added in order of height.
+func (m *ChainMonitor) AddBlock(block BlockSignatures) {
+	signed := make(map[string]bool)
+	for _, signer := range block.Signers {
+		signed[signer] = true
+	}
+
+	// signatures are counted like the monitoring module of the chain does for the rewards
+	for _, val := range m.validators {
+		if _, ok := block.VotingPower[val.consAddress]; !ok {
+			continue
+		}
+		if signed[val.consAddress] {
+			m.signatureCounts.AddSignature(val.operatorAddress, int64(len(block.VotingPower)))
+		} else {
+			m.missed[val.consAddress]++
+		}
+	}
+	m.signatureCounts.BlockCount++
+
+	m.blocks++
+	m.height = block.Height
+	m.time = block.Time
+	m.lastBlock = block
+}
+
+// Status returns the status of the chain from the monitored blocks.
+func (m *ChainMonitor) Status() MonitorStatus {
+	status := MonitorStatus{
+		Height: m.height,
+		Time:   m.time,
+		Blocks: m.blocks,
+		MonitoringPacket: spntypes.MonitoringPacket{
+			BlockHeight:     m.height,
+			SignatureCounts: m.signatureCounts,
+		},
+	}
+
+	signed := make(map[string]bool)
+	for _, signer := range m.lastBlock.Signers {
+		signed[signer] = true
+	}
+
+	var total, online int64
+	for address, power := range m.lastBlock.VotingPower {
+		total += power
+		if signed[address] {
+			online += power
+		}
+	}
+	if total > 0 {
+		status.OnlineVotingPower = float64(online) * 100 / float64(total)
+	}
+
+	for _, val := range m.validators {
+		status.Validators = append(status.Validators, MonitorValidatorStatus{
+			Address:         val.address,
+			OperatorAddress: val.operatorAddress,
+			Signing:         signed[val.consAddress],
+			MissedBlocks:    m.missed[val.consAddress],
+			VotingPower:     m.lastBlock.VotingPower[val.consAddress],
+		})
+	}
+	sort.Slice(status.Validators, func(i, j int) bool {
+		return status.Validators[i].Address < status.Validators[j].Address
+	})
+
+	return status
+}
+
+// BlockClient fetches the blocks of a chain, it is implemented by the Tendermint RPC client.
+type BlockClient interface {
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
+	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
+}
+
+// Sync adds the blocks committed since the last monitored block, when more than maxBlocks blocks
+// are committed only the latest ones are added.
+func (m *ChainMonitor) Sync(ctx context.Context, client BlockClient, maxBlocks int64) error {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	latest := status.SyncInfo.LatestBlockHeight
+	from := m.height + 1
+	if latest-from >= maxBlocks {
+		from = latest - maxBlocks + 1
+	}
+
+	for height := from; height <= latest; height++ {
+		h := height
+		commit, err := client.Commit(ctx, &h)
+		if err != nil {
+			return err
+		}
+
+		validators, err := blockValidators(ctx, client, height)
+		if err != nil {
+			return err
+		}
+
+		m.AddBlock(NewBlockSignatures(*commit.Header, commit.Commit, validators))
+	}
+
+	return nil
+}
+
+// blockValidators fetches all the pages of the validator set of the block at height.
+func blockValidators(ctx context.Context, client BlockClient, height int64) ([]*tmtypes.Validator, error) {
+	var (
+		validators []*tmtypes.Validator
+		perPage    = 100
+	)
+	for page := 1; ; page++ {
+		p := page
+		res, err := client.Validators(ctx, &height, &p, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			return validators, nil
+		}
+	}
+}
+
+// NewBlockSignatures returns the signatures of the block committed by commit with the validator set of the block.
+func NewBlockSignatures(header tmtypes.Header, commit *tmtypes.Commit, validators []*tmtypes.Validator) BlockSignatures {
+	block := BlockSignatures{
+		Height:      header.Height,
+		Time:        header.Time,
+		VotingPower: make(map[string]int64),
+	}
+	for _, val := range validators {
+		block.VotingPower[val.Address.String()] = val.VotingPower
+	}
+	for _, sig := range commit.Signatures {
+		if sig.ForBlock() {
+			block.Signers = append(block.Signers, sig.ValidatorAddress.String())
+		}
+	}
+	return block
+}
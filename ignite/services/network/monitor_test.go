@@ -0,0 +1,131 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	spntypes "github.com/tendermint/spn/pkg/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+// monitorTestClient is a chain where the validators sign the blocks of signed.
+type monitorTestClient struct {
+	validators []*tmtypes.Validator
+	signed     map[int64][]int
+	latest     int64
+}
+
+func (c monitorTestClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: c.latest}}, nil
+}
+
+func (c monitorTestClient) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	commit := &tmtypes.Commit{Height: *height}
+	for _, i := range c.signed[*height] {
+		commit.Signatures = append(commit.Signatures, tmtypes.CommitSig{
+			BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+			ValidatorAddress: c.validators[i].Address,
+		})
+	}
+	header := &tmtypes.Header{Height: *height, Time: time.Unix(*height, 0).UTC()}
+	return ctypes.NewResultCommit(header, commit, true), nil
+}
+
+func (c monitorTestClient) Validators(_ context.Context, height *int64, _, _ *int) (*ctypes.ResultValidators, error) {
+	return &ctypes.ResultValidators{
+		BlockHeight: *height,
+		Validators:  c.validators,
+		Total:       len(c.validators),
+	}, nil
+}
+
+func monitorTestGenesisValidator(address, operatorAddress string, pubKey ed25519.PubKey) networktypes.GenesisValidator {
+	return networktypes.GenesisValidator{
+		Address: address,
+		Gentx: []byte(fmt.Sprintf(`{"body": {"messages": [{
+  "delegator_address": "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+  "validator_address": "%s",
+  "pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "%s"},
+  "value": {"amount": "1000", "denom": "stake"}
+}]}}`, operatorAddress, base64.StdEncoding.EncodeToString(pubKey))),
+	}
+}
+
+func TestChainMonitor(t *testing.T) {
+	var (
+		key1 = ed25519.GenPrivKey().PubKey().(ed25519.PubKey)
+		key2 = ed25519.GenPrivKey().PubKey().(ed25519.PubKey)
+
+		operator1 = "cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup"
+		operator2 = "cosmosvaloper1mmlqwyqk7neqegffp99q86eckpm4pjah5sl2dw"
+	)
+
+	client := monitorTestClient{
+		validators: []*tmtypes.Validator{
+			tmtypes.NewValidator(key1, 30),
+			tmtypes.NewValidator(key2, 10),
+		},
+		signed: map[int64][]int{
+			1: {0, 1},
+			2: {0},
+			3: {0},
+			4: {0, 1},
+		},
+		latest: 3,
+	}
+
+	m, err := NewChainMonitor([]networktypes.GenesisValidator{
+		monitorTestGenesisValidator("spn1", operator1, key1),
+		monitorTestGenesisValidator("spn2", operator2, key2),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, m.Sync(context.Background(), client, 100))
+	status := m.Status()
+
+	require.EqualValues(t, 3, status.Height)
+	require.EqualValues(t, 3, status.Blocks)
+	require.Equal(t, time.Unix(3, 0).UTC(), status.Time)
+	require.Equal(t, float64(75), status.OnlineVotingPower)
+	require.Equal(t, []MonitorValidatorStatus{
+		{Address: "spn1", OperatorAddress: operator1, Signing: true, VotingPower: 30},
+		{Address: "spn2", OperatorAddress: operator2, MissedBlocks: 2, VotingPower: 10},
+	}, status.Validators)
+
+	half := sdk.NewDecWithPrec(5, 1)
+	require.Equal(t, spntypes.MonitoringPacket{
+		BlockHeight: 3,
+		SignatureCounts: spntypes.SignatureCounts{
+			BlockCount: 3,
+			Counts: []spntypes.SignatureCount{
+				{OpAddress: operator1, RelativeSignatures: half.MulInt64(3)},
+				{OpAddress: operator2, RelativeSignatures: half},
+			},
+		},
+	}, status.MonitoringPacket)
+	require.NoError(t, status.MonitoringPacket.ValidateBasic())
+
+	// only the new blocks are added
+	client.latest = 4
+	require.NoError(t, m.Sync(context.Background(), client, 100))
+	status = m.Status()
+	require.EqualValues(t, 4, status.Blocks)
+	require.Equal(t, float64(100), status.OnlineVotingPower)
+	require.EqualValues(t, 2, status.Validators[1].MissedBlocks)
+
+	// only the latest blocks are added when the monitor is late
+	m, err = NewChainMonitor(nil)
+	require.NoError(t, err)
+	require.NoError(t, m.Sync(context.Background(), client, 2))
+	require.EqualValues(t, 2, m.Status().Blocks)
+	require.EqualValues(t, 4, m.Height())
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/pkg/errors"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
@@ -40,6 +41,7 @@ type Network struct {
 	profileQuery  profiletypes.QueryClient
 	rewardQuery   rewardtypes.QueryClient
 	stakingQuery  stakingtypes.QueryClient
+	bankQuery     banktypes.QueryClient
 }
 
 //go:generate mockery --name Chain --case underscore
@@ -91,6 +93,12 @@ func WithStakingQueryClient(client stakingtypes.QueryClient) Option {
 	}
 }
 
+func WithBankQueryClient(client banktypes.QueryClient) Option {
+	return func(n *Network) {
+		n.bankQuery = client
+	}
+}
+
 // CollectEvents collects events from the network builder.
 func CollectEvents(ev events.Bus) Option {
 	return func(n *Network) {
@@ -108,6 +116,7 @@ func New(cosmos CosmosClient, account cosmosaccount.Account, options ...Option)
 		profileQuery:  profiletypes.NewQueryClient(cosmos.Context()),
 		rewardQuery:   rewardtypes.NewQueryClient(cosmos.Context()),
 		stakingQuery:  stakingtypes.NewQueryClient(cosmos.Context()),
+		bankQuery:     banktypes.NewQueryClient(cosmos.Context()),
 	}
 	for _, opt := range options {
 		opt(&n)
@@ -0,0 +1,189 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/checksum"
+	"github.com/ignite-hq/cli/ignite/pkg/events"
+	"github.com/ignite-hq/cli/ignite/pkg/goenv"
+)
+
+// checksumExtension is the extension of the file with the checksum of a binary in a cache directory.
+const checksumExtension = ".sha256"
+
+// cacheDirBinaryPath returns the path of a binary built from the source hash for the chain ID in a
+// cache directory. binaries are stored by source hash, chain ID and platform so a directory can be
+// shared between machines, the chain ID is part of the key since it is set in the binary on build.
+func cacheDirBinaryPath(dir, sourceHash, chainID, binaryName string) string {
+	return filepath.Join(dir, sourceHash, chainID, runtime.GOOS+"-"+runtime.GOARCH, binaryName)
+}
+
+// InstallFromCacheDir installs the binary of the chain from a cache directory shared with
+// SaveToCacheDir, found is false when the directory has no binary for the source and the chain ID
+// of the chain.
+func (c *Chain) InstallFromCacheDir(dir string) (binaryName string, found bool, err error) {
+	if binaryName, err = c.chain.Binary(); err != nil {
+		return "", false, err
+	}
+
+	binaryPath := cacheDirBinaryPath(dir, c.hash, c.id, binaryName)
+	expected, err := os.ReadFile(binaryPath + checksumExtension)
+	if os.IsNotExist(err) {
+		return binaryName, false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	c.ev.Send(events.New(events.StatusOngoing, "Installing the chain's binary from the cache directory"))
+
+	binaryChecksum, err := checksum.File(binaryPath)
+	if err != nil {
+		return "", false, err
+	}
+	if binaryChecksum != strings.TrimSpace(string(expected)) {
+		return "", false, fmt.Errorf("the checksum of the binary %s doesn't match its checksum file", binaryPath)
+	}
+
+	if err := os.MkdirAll(goenv.Bin(), 0755); err != nil {
+		return "", false, err
+	}
+	if err := copyBinary(binaryPath, filepath.Join(goenv.Bin(), binaryName)); err != nil {
+		return "", false, err
+	}
+
+	// cache installed binary for launch id
+	if c.launchID != 0 {
+		if err := c.CacheBinary(c.launchID); err != nil {
+			return "", false, err
+		}
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Chain's binary installed from the cache directory"))
+
+	return binaryName, true, nil
+}
+
+// SaveToCacheDir saves the installed binary of the chain with its checksum in a cache directory.
+// The installed binary is rebuilt from the source first when it wasn't built by Build, since a
+// binary accepted from the binary cache of the launch ID may predate the reproducible builds.
+func (c *Chain) SaveToCacheDir(ctx context.Context, cacheStorage cache.Storage, dir string) error {
+	binaryName, err := c.chain.Binary()
+	if err != nil {
+		return err
+	}
+	installedPath, err := exec.LookPath(binaryName)
+	if err != nil {
+		return err
+	}
+	binaryChecksum, err := checksum.File(installedPath)
+	if err != nil {
+		return err
+	}
+
+	if binaryChecksum != c.builtChecksum {
+		out, err := os.MkdirTemp("", "")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(out)
+
+		c.ev.Send(events.New(events.StatusOngoing, "Building the chain's binary from the source"))
+
+		if _, err := c.chain.Build(ctx, cacheStorage, out); err != nil {
+			return err
+		}
+
+		c.ev.Send(events.New(events.StatusDone, "Chain's binary built"))
+
+		installedPath = filepath.Join(out, binaryName)
+		if binaryChecksum, err = checksum.File(installedPath); err != nil {
+			return err
+		}
+	}
+
+	binaryPath := cacheDirBinaryPath(dir, c.hash, c.id, binaryName)
+	if err := os.MkdirAll(filepath.Dir(binaryPath), 0755); err != nil {
+		return err
+	}
+	if err := copyBinary(installedPath, binaryPath); err != nil {
+		return err
+	}
+	return os.WriteFile(binaryPath+checksumExtension, []byte(binaryChecksum+"\n"), 0644)
+}
+
+// VerifyBinary rebuilds the binary of the chain from its source in a temporary directory and
+// returns the checksum of the rebuilt binary and the checksum of the binary at binaryPath.
+func (c *Chain) VerifyBinary(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	binaryPath string,
+) (sourceChecksum, binaryChecksum string, err error) {
+	if binaryChecksum, err = checksum.File(binaryPath); err != nil {
+		return "", "", err
+	}
+
+	out, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.RemoveAll(out)
+
+	c.ev.Send(events.New(events.StatusOngoing, "Building the chain's binary from the source"))
+
+	binaryName, err := c.chain.Build(ctx, cacheStorage, out)
+	if err != nil {
+		return "", "", err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Chain's binary built"))
+
+	if sourceChecksum, err = checksum.File(filepath.Join(out, binaryName)); err != nil {
+		return "", "", err
+	}
+	return sourceChecksum, binaryChecksum, nil
+}
+
+// copyBinary copies the executable file at src to dst with the permissions of src.
+func copyBinary(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	// the file is written next to dst and renamed to replace a running binary
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	// the permissions are set again since they are masked by the umask on creation
+	if err := out.Chmod(info.Mode().Perm()); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
@@ -0,0 +1,181 @@
+package networkchain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/checksum"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+const (
+	testSourceHash = "abcdef"
+	testChainID    = "mars-1"
+	testBinaryName = "marsd"
+)
+
+// newTestChain returns a chain with its source in a temporary directory and sets a temporary
+// directory as the GOBIN where the binary of the chain is installed.
+func newTestChain(t *testing.T) (c *Chain, bin string) {
+	path := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(path, "go.mod"), []byte(`module github.com/test/mars
+
+go 1.18
+
+require github.com/cosmos/cosmos-sdk v0.45.4
+`), 0644))
+
+	ch, err := chain.New(path)
+	require.NoError(t, err)
+
+	bin = t.TempDir()
+	t.Setenv("GOBIN", bin)
+	t.Setenv("PATH", bin)
+
+	return &Chain{id: testChainID, hash: testSourceHash, chain: ch}, bin
+}
+
+// installTestBinary installs a binary with content for c as if it was built by Build.
+func installTestBinary(t *testing.T, c *Chain, bin, content string) {
+	path := filepath.Join(bin, testBinaryName)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0755))
+
+	var err error
+	c.builtChecksum, err = checksum.File(path)
+	require.NoError(t, err)
+}
+
+func TestCacheDirBinaryPath(t *testing.T) {
+	require.Equal(t,
+		filepath.Join("cache", testSourceHash, testChainID, runtime.GOOS+"-"+runtime.GOARCH, testBinaryName),
+		cacheDirBinaryPath("cache", testSourceHash, testChainID, testBinaryName),
+	)
+}
+
+func TestSaveToCacheDirAndInstallFromCacheDir(t *testing.T) {
+	c, bin := newTestChain(t)
+	installTestBinary(t, c, bin, "binary")
+	dir := t.TempDir()
+
+	require.NoError(t, c.SaveToCacheDir(context.Background(), cache.Storage{}, dir))
+
+	binaryPath := cacheDirBinaryPath(dir, testSourceHash, testChainID, testBinaryName)
+	binaryChecksum, err := checksum.File(binaryPath)
+	require.NoError(t, err)
+	expected, err := os.ReadFile(binaryPath + checksumExtension)
+	require.NoError(t, err)
+	require.Equal(t, binaryChecksum+"\n", string(expected))
+
+	// the binary installed from the cache directory replaces the installed one.
+	installTestBinary(t, c, bin, "other binary")
+
+	binaryName, found, err := c.InstallFromCacheDir(dir)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, testBinaryName, binaryName)
+
+	content, err := os.ReadFile(filepath.Join(bin, testBinaryName))
+	require.NoError(t, err)
+	require.Equal(t, "binary", string(content))
+}
+
+func TestSaveToCacheDirNotBuiltBinary(t *testing.T) {
+	c, bin := newTestChain(t)
+	installTestBinary(t, c, bin, "binary")
+	dir := t.TempDir()
+
+	// the installed binary wasn't built by Build, it is rebuilt from the source
+	// that cannot be built.
+	c.builtChecksum = ""
+
+	require.Error(t, c.SaveToCacheDir(context.Background(), cache.Storage{}, dir))
+
+	_, err := os.Stat(cacheDirBinaryPath(dir, testSourceHash, testChainID, testBinaryName))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestInstallFromCacheDirChecksumMismatch(t *testing.T) {
+	c, bin := newTestChain(t)
+	installTestBinary(t, c, bin, "binary")
+	dir := t.TempDir()
+
+	require.NoError(t, c.SaveToCacheDir(context.Background(), cache.Storage{}, dir))
+
+	binaryPath := cacheDirBinaryPath(dir, testSourceHash, testChainID, testBinaryName)
+	require.NoError(t, os.WriteFile(binaryPath, []byte("modified binary"), 0755))
+	require.NoError(t, os.Remove(filepath.Join(bin, testBinaryName)))
+
+	_, _, err := c.InstallFromCacheDir(dir)
+	require.Error(t, err)
+
+	// the binary that doesn't match its checksum is not installed.
+	_, err = os.Stat(filepath.Join(bin, testBinaryName))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestInstallFromCacheDirOtherChainID(t *testing.T) {
+	c, bin := newTestChain(t)
+	installTestBinary(t, c, bin, "binary")
+	dir := t.TempDir()
+
+	require.NoError(t, c.SaveToCacheDir(context.Background(), cache.Storage{}, dir))
+	require.NoError(t, os.Remove(filepath.Join(bin, testBinaryName)))
+
+	// the chain ID is set in the binary on build, the binary of another chain ID is not installed.
+	c.id = "mars-2"
+
+	_, found, err := c.InstallFromCacheDir(dir)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = os.Stat(filepath.Join(bin, testBinaryName))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestInstallFromCacheDirNotFound(t *testing.T) {
+	c, bin := newTestChain(t)
+
+	binaryName, found, err := c.InstallFromCacheDir(t.TempDir())
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, testBinaryName, binaryName)
+
+	_, err = os.Stat(filepath.Join(bin, testBinaryName))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestVerifyBinaryNotFound(t *testing.T) {
+	c, bin := newTestChain(t)
+
+	_, _, err := c.VerifyBinary(context.Background(), cache.Storage{}, filepath.Join(bin, testBinaryName))
+	require.Error(t, err)
+}
+
+func TestCopyBinary(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	require.NoError(t, os.WriteFile(src, []byte("binary"), 0750))
+
+	// an existing file with other permissions is replaced.
+	require.NoError(t, os.WriteFile(dst, []byte("old binary"), 0600))
+
+	require.NoError(t, copyBinary(src, dst))
+
+	content, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	require.Equal(t, "binary", string(content))
+
+	info, err := os.Stat(dst)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0750), info.Mode().Perm())
+
+	_, err = os.Stat(dst + ".tmp")
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,76 @@
+package networkchain
+
+import (
+	"context"
+	"os"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/pkg/events"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+// DiffRequests builds the genesis from the genesis information before and after applying the
+// provided requests and returns the semantic difference between the two genesis
+func (c Chain) DiffRequests(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	gi networktypes.GenesisInformation,
+	reqs []networktypes.Request,
+) (diff cosmosutil.GenesisDiff, err error) {
+	c.ev.Send(events.New(events.StatusOngoing, "Verifying requests format"))
+	after := gi
+	for _, req := range reqs {
+		// static verification of the request
+		if err := networktypes.VerifyRequest(req); err != nil {
+			return diff, err
+		}
+
+		// apply the request to the genesis information
+		after, err = after.ApplyRequest(req)
+		if err != nil {
+			return diff, err
+		}
+	}
+	c.ev.Send(events.New(events.StatusDone, "Requests format verified"))
+
+	if err := c.Init(ctx, cacheStorage); err != nil {
+		return diff, err
+	}
+
+	genesisBefore, err := c.genesisFromInformation(ctx, gi)
+	if err != nil {
+		return diff, err
+	}
+	genesisAfter, err := c.genesisFromInformation(ctx, after)
+	if err != nil {
+		return diff, err
+	}
+
+	return cosmosutil.DiffGenesis(genesisBefore, genesisAfter)
+}
+
+// genesisFromInformation builds the genesis of the initialized chain from the genesis information
+// with the same parameters as the simulation and returns it
+func (c *Chain) genesisFromInformation(ctx context.Context, gi networktypes.GenesisInformation) ([]byte, error) {
+	if err := c.initGenesis(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := c.buildGenesis(
+		ctx,
+		gi,
+		networktypes.Reward{RevisionHeight: 1},
+		networktypes.SPNChainID,
+		1,
+		2,
+	); err != nil {
+		return nil, err
+	}
+
+	genesisPath, err := c.chain.GenesisPath()
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(genesisPath)
+}
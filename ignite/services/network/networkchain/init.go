@@ -60,12 +60,14 @@ func (c *Chain) initGenesis(ctx context.Context) error {
 		return err
 	}
 
-	// if the blockchain has a genesis URL, the initial genesis is fetched from the URL
+	// if the blockchain has a genesis URL, the initial genesis is fetched from the URL unless its content is provided
 	// otherwise, the default genesis is used, which requires no action since the default genesis is generated from the init command
-	if c.genesisURL != "" {
-		genesis, hash, err := cosmosutil.GenesisAndHashFromURL(ctx, c.genesisURL)
-		if err != nil {
-			return err
+	if c.genesisURL != "" || c.initialGenesis != nil {
+		genesis, hash := c.initialGenesis, cosmosutil.GenesisHash(c.initialGenesis)
+		if genesis == nil {
+			if genesis, hash, err = cosmosutil.GenesisAndHashFromURL(ctx, c.genesisURL); err != nil {
+				return err
+			}
 		}
 
 		// if the blockchain has been initialized with no genesis hash, we assign the fetched hash to it
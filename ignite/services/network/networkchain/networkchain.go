@@ -29,16 +29,20 @@ type Chain struct {
 	path string
 	home string
 
-	url         string
-	hash        string
-	genesisURL  string
-	genesisHash string
-	launchTime  int64
+	url            string
+	hash           string
+	genesisURL     string
+	genesisHash    string
+	initialGenesis []byte
+	launchTime     int64
 
 	keyringBackend chaincmd.KeyringBackend
 
 	isInitialized bool
 
+	// builtChecksum is the checksum of the binary built from the source by Build.
+	builtChecksum string
+
 	ref plumbing.ReferenceName
 
 	chain *chain.Chain
@@ -118,6 +122,14 @@ func WithGenesisFromURL(genesisURL string) Option {
 	}
 }
 
+// WithInitialGenesis provides the content of the initial genesis of the blockchain, it is used
+// instead of the genesis URL to initialize the blockchain offline
+func WithInitialGenesis(genesis []byte) Option {
+	return func(c *Chain) {
+		c.initialGenesis = genesis
+	}
+}
+
 // CollectEvents collects events from the chain.
 func CollectEvents(ev events.Bus) Option {
 	return func(c *Chain) {
@@ -149,6 +161,8 @@ func New(ctx context.Context, ar cosmosaccount.Registry, source SourceOption, op
 		chain.ID(c.id),
 		chain.HomePath(c.home),
 		chain.LogLevel(chain.LogSilent),
+		// builds must be reproducible to share and verify the binaries of a launch
+		chain.TrimPath(),
 	}
 
 	// use test keyring backend on Gitpod in order to prevent prompting for keyring
@@ -282,6 +296,10 @@ func (c *Chain) Build(ctx context.Context, cacheStorage cache.Storage) (binaryNa
 
 	c.ev.Send(events.New(events.StatusDone, "Chain's binary built"))
 
+	if c.builtChecksum, err = checksum.Binary(binaryName); err != nil {
+		return "", err
+	}
+
 	// cache built binary for launch id
 	if c.launchID != 0 {
 		if err := c.CacheBinary(c.launchID); err != nil {
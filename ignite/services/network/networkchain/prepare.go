@@ -91,7 +91,7 @@ func (c Chain) Prepare(
 
 	// ensure genesis has a valid format
 	if err := cmd.ValidateGenesis(ctx); err != nil {
-		return err
+		return fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
 	}
 
 	// reset the saved state in case the chain has been started before
@@ -120,13 +120,13 @@ func (c Chain) buildGenesis(
 
 	// apply genesis information to the genesis
 	if err := c.applyGenesisAccounts(ctx, gi.GenesisAccounts, addressPrefix); err != nil {
-		return errors.Wrap(err, "error applying genesis accounts to genesis")
+		return fmt.Errorf("%w: error applying genesis accounts to genesis: %v", ErrInvalidGenesis, err)
 	}
 	if err := c.applyVestingAccounts(ctx, gi.VestingAccounts, addressPrefix); err != nil {
-		return errors.Wrap(err, "error applying vesting accounts to genesis")
+		return fmt.Errorf("%w: error applying vesting accounts to genesis: %v", ErrInvalidGenesis, err)
 	}
 	if err := c.applyGenesisValidators(ctx, gi.GenesisValidators); err != nil {
-		return errors.Wrap(err, "error applying genesis validators to genesis")
+		return fmt.Errorf("%w: error applying genesis validators to genesis: %v", ErrInvalidGenesis, err)
 	}
 
 	genesisPath, err := c.chain.GenesisPath()
@@ -24,6 +24,11 @@ const (
 	ValidatorSetNilErrorMessage = "validator set is nil in genesis and still empty after InitChain"
 )
 
+// ErrInvalidGenesis is returned when the genesis cannot be built with the requests
+// or the chain cannot be started with it. other errors of the simulation, like a failed
+// build of the chain, don't tell whether the requests are valid.
+var ErrInvalidGenesis = errors.New("invalid genesis")
+
 // SimulateRequests simulates the genesis creation and the start of the network from the provided requests
 func (c Chain) SimulateRequests(
 	ctx context.Context,
@@ -35,13 +40,18 @@ func (c Chain) SimulateRequests(
 	for _, req := range reqs {
 		// static verification of the request
 		if err := networktypes.VerifyRequest(req); err != nil {
-			return err
+			return fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
+		}
+
+		// the gentxs must be signed for the chain
+		if err := networktypes.VerifyRequestSignature(req, c.id); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
 		}
 
 		// apply the request to the genesis information
 		gi, err = gi.ApplyRequest(req)
 		if err != nil {
-			return err
+			return fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
 		}
 	}
 	c.ev.Send(events.New(events.StatusDone, "Requests format verified"))
@@ -101,7 +111,10 @@ func (c Chain) simulateChainStart(ctx context.Context) error {
 		if err != nil && strings.Contains(err.Error(), ValidatorSetNilErrorMessage) {
 			err = nil
 		}
-		exit <- errors.Wrap(err, "the chain failed to start")
+		if err != nil && ctx.Err() == nil {
+			err = fmt.Errorf("%w: the chain failed to start: %v", ErrInvalidGenesis, err)
+		}
+		exit <- err
 	}()
 
 	return <-exit
@@ -0,0 +1,105 @@
+package networkchain
+
+import (
+	"fmt"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/confile"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+// ValidatorFile is a file that holds the information of the validator created by the gentx of a chain
+// under the validator key.
+type ValidatorFile struct {
+	Validator ValidatorConfig `json:"validator" yaml:"validator"`
+}
+
+// ValidatorConfig is the information of a validator in a validator file.
+type ValidatorConfig struct {
+	Moniker                 string `json:"moniker" yaml:"moniker"`
+	Website                 string `json:"website" yaml:"website"`
+	Identity                string `json:"identity" yaml:"identity"`
+	Details                 string `json:"details" yaml:"details"`
+	SecurityContact         string `json:"security_contact" yaml:"security_contact"`
+	StakingAmount           string `json:"staking_amount" yaml:"staking_amount"`
+	SelfDelegation          string `json:"self_delegation" yaml:"self_delegation"`
+	GasPrice                string `json:"gas_price" yaml:"gas_price"`
+	CommissionRate          string `json:"commission_rate" yaml:"commission_rate"`
+	CommissionMaxRate       string `json:"commission_max_rate" yaml:"commission_max_rate"`
+	CommissionMaxChangeRate string `json:"commission_max_change_rate" yaml:"commission_max_change_rate"`
+}
+
+// LoadValidatorFile loads the validator information from the YAML file at path.
+func LoadValidatorFile(path string) (chain.Validator, error) {
+	if _, err := os.Stat(path); err != nil {
+		return chain.Validator{}, err
+	}
+
+	var file ValidatorFile
+	if err := confile.New(confile.DefaultYAMLEncodingCreator, path).Load(&file); err != nil {
+		return chain.Validator{}, fmt.Errorf("invalid validator file %s: %w", path, err)
+	}
+
+	v := file.Validator
+	return chain.Validator{
+		Moniker:                 v.Moniker,
+		Website:                 v.Website,
+		Identity:                v.Identity,
+		Details:                 v.Details,
+		SecurityContact:         v.SecurityContact,
+		StakingAmount:           v.StakingAmount,
+		MinSelfDelegation:       v.SelfDelegation,
+		GasPrices:               v.GasPrice,
+		CommissionRate:          v.CommissionRate,
+		CommissionMaxRate:       v.CommissionMaxRate,
+		CommissionMaxChangeRate: v.CommissionMaxChangeRate,
+	}, nil
+}
+
+// CheckValidator checks locally that the gentx of the validator can be created and accepted by the
+// chain, the staking amount must be in the stake denom of the chain, the commission rates must be
+// consistent and the min self-delegation can't exceed the staking amount.
+func CheckValidator(v chain.Validator, stakeDenom string) error {
+	stake, err := sdk.ParseCoinNormalized(v.StakingAmount)
+	if err != nil {
+		return fmt.Errorf("invalid staking amount %s: %w", v.StakingAmount, err)
+	}
+	if stake.Denom != stakeDenom {
+		return fmt.Errorf("the staking amount %s must be in the stake denom %s", v.StakingAmount, stakeDenom)
+	}
+	if !stake.IsPositive() {
+		return fmt.Errorf("the staking amount %s must be positive", v.StakingAmount)
+	}
+
+	commission, err := cosmosutil.ParseCommissionRates(v.CommissionRate, v.CommissionMaxRate, v.CommissionMaxChangeRate)
+	if err != nil {
+		return err
+	}
+	if err := commission.Validate(); err != nil {
+		return fmt.Errorf("invalid commission: %w", err)
+	}
+
+	if v.MinSelfDelegation != "" {
+		minSelfDelegation, ok := sdk.NewIntFromString(v.MinSelfDelegation)
+		if !ok || !minSelfDelegation.IsPositive() {
+			return fmt.Errorf("invalid self-delegation %s: must be a positive integer", v.MinSelfDelegation)
+		}
+		if minSelfDelegation.GT(stake.Amount) {
+			return fmt.Errorf(
+				"the self-delegation %s can't be greater than the staking amount %s",
+				v.MinSelfDelegation,
+				v.StakingAmount,
+			)
+		}
+	}
+
+	if v.GasPrices != "" {
+		if _, err := sdk.ParseDecCoins(v.GasPrices); err != nil {
+			return fmt.Errorf("invalid gas price %s: %w", v.GasPrices, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package networkchain_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/services/chain"
+	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+)
+
+func TestLoadValidatorFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "validator.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`validator:
+  moniker: alice
+  staking_amount: 95000000stake
+  self_delegation: "1000"
+  commission_rate: "0.05"
+  commission_max_rate: "0.10"
+  commission_max_change_rate: "0.01"
+`), 0644))
+
+	v, err := networkchain.LoadValidatorFile(path)
+	require.NoError(t, err)
+	require.Equal(t, chain.Validator{
+		Moniker:                 "alice",
+		StakingAmount:           "95000000stake",
+		MinSelfDelegation:       "1000",
+		CommissionRate:          "0.05",
+		CommissionMaxRate:       "0.10",
+		CommissionMaxChangeRate: "0.01",
+	}, v)
+
+	_, err = networkchain.LoadValidatorFile(filepath.Join(t.TempDir(), "not_found.yml"))
+	require.Error(t, err)
+}
+
+func TestCheckValidator(t *testing.T) {
+	validator := func(apply func(*chain.Validator)) chain.Validator {
+		v := chain.Validator{
+			StakingAmount:           "95000000stake",
+			MinSelfDelegation:       "1",
+			GasPrices:               "0stake",
+			CommissionRate:          "0.10",
+			CommissionMaxRate:       "0.20",
+			CommissionMaxChangeRate: "0.01",
+		}
+		if apply != nil {
+			apply(&v)
+		}
+		return v
+	}
+
+	tests := []struct {
+		name      string
+		validator chain.Validator
+		wantErr   bool
+	}{
+		{
+			name:      "valid validator",
+			validator: validator(nil),
+		},
+		{
+			name:      "no self-delegation",
+			validator: validator(func(v *chain.Validator) { v.MinSelfDelegation = "" }),
+		},
+		{
+			name:      "self-delegation equal to the stake",
+			validator: validator(func(v *chain.Validator) { v.MinSelfDelegation = "95000000" }),
+		},
+		{
+			name:      "self-delegation greater than the stake",
+			validator: validator(func(v *chain.Validator) { v.MinSelfDelegation = "95000001" }),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid self-delegation",
+			validator: validator(func(v *chain.Validator) { v.MinSelfDelegation = "-1" }),
+			wantErr:   true,
+		},
+		{
+			name:      "staking amount in another denom",
+			validator: validator(func(v *chain.Validator) { v.StakingAmount = "95000000foo" }),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid staking amount",
+			validator: validator(func(v *chain.Validator) { v.StakingAmount = "stake" }),
+			wantErr:   true,
+		},
+		{
+			name:      "max rate lower than the rate",
+			validator: validator(func(v *chain.Validator) { v.CommissionMaxRate = "0.05" }),
+			wantErr:   true,
+		},
+		{
+			name:      "max rate greater than one",
+			validator: validator(func(v *chain.Validator) { v.CommissionMaxRate = "1.5" }),
+			wantErr:   true,
+		},
+		{
+			name:      "max change rate greater than the max rate",
+			validator: validator(func(v *chain.Validator) { v.CommissionMaxChangeRate = "0.30" }),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid rate",
+			validator: validator(func(v *chain.Validator) { v.CommissionRate = "ten" }),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid gas price",
+			validator: validator(func(v *chain.Validator) { v.GasPrices = "stake0" }),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := networkchain.CheckValidator(tt.validator, "stake")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
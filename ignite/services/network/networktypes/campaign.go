@@ -44,3 +44,12 @@ func ToMainnetAccount(acc campaigntypes.MainnetAccount) MainnetAccount {
 		Shares:  acc.Shares,
 	}
 }
+
+// CampaignAccount represents the shares and the vouchers of an account in a campaign on SPN
+type CampaignAccount struct {
+	Address  string               `json:"Address"`
+	Shares   campaigntypes.Shares `json:"Shares"`
+	Vouchers sdk.Coins            `json:"Vouchers"`
+	// Coins are the coins of the mainnet account from the total supply of the campaign.
+	Coins sdk.Coins `json:"Coins"`
+}
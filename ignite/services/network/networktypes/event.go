@@ -0,0 +1,26 @@
+package networktypes
+
+// Event payloads are attached to the events of the network operations, so they can be
+// consumed by machines, e.g. in the JSON output of the commands.
+
+type (
+	// LaunchPayload is the payload of the events of a chain launch.
+	LaunchPayload struct {
+		LaunchID   uint64 `json:"launch_id"`
+		LaunchTime int64  `json:"launch_time,omitempty"`
+		Reverted   bool   `json:"reverted,omitempty"`
+	}
+
+	// RequestPayload is the payload of the events of a request submitted to a chain.
+	RequestPayload struct {
+		LaunchID     uint64 `json:"launch_id"`
+		RequestID    uint64 `json:"request_id,omitempty"`
+		AutoApproved bool   `json:"auto_approved"`
+	}
+
+	// CampaignPayload is the payload of the events of a campaign.
+	CampaignPayload struct {
+		CampaignID uint64 `json:"campaign_id"`
+		MainnetID  uint64 `json:"mainnet_id,omitempty"`
+	}
+)
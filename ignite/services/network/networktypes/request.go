@@ -48,6 +48,20 @@ func VerifyRequest(request Request) error {
 	return nil
 }
 
+// VerifyRequestSignature verifies offline the gentx signature of an add validator request
+// against the chain ID, other requests have no signature to verify
+func VerifyRequestSignature(request Request, chainID string) error {
+	req, ok := request.Content.Content.(*launchtypes.RequestContent_GenesisValidator)
+	if !ok {
+		return nil
+	}
+
+	if err := cosmosutil.VerifyGentxSignature(req.GenesisValidator.GenTx, chainID); err != nil {
+		return NewWrappedErrInvalidRequest(request.RequestID, err.Error())
+	}
+	return nil
+}
+
 // VerifyAddValidatorRequest verify the validator request parameters
 func VerifyAddValidatorRequest(req *launchtypes.RequestContent_GenesisValidator) error {
 	// If this is an add validator request
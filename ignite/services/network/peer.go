@@ -1,11 +1,22 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff"
 	"github.com/pkg/errors"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
+	tmconn "github.com/tendermint/tendermint/p2p/conn"
+
+	"github.com/ignite-hq/cli/ignite/pkg/availableport"
+	"github.com/ignite-hq/cli/ignite/pkg/xchisel"
 )
 
 func PeerAddress(peer launchtypes.Peer) (string, error) {
@@ -28,3 +39,72 @@ func ParsePeerAddress(addr string) (launchtypes.Peer, error) {
 	}
 	return launchtypes.NewPeerConn(sp[0], sp[1]), nil
 }
+
+const (
+	// peerDialTimeout is the timeout to dial the address of a peer.
+	peerDialTimeout = 5 * time.Second
+
+	// tunnelP2PPort is the P2P port of the nodes behind an HTTP tunnel.
+	tunnelP2PPort = "26656"
+)
+
+// VerifyPeer dials peer and checks that it answers with its node ID in the P2P handshake.
+// a local tunnel client is started to dial peers connected through an HTTP tunnel.
+func VerifyPeer(ctx context.Context, peer launchtypes.Peer) error {
+	ctx, cancel := context.WithTimeout(ctx, peerDialTimeout)
+	defer cancel()
+
+	var address string
+	switch conn := peer.Connection.(type) {
+	case *launchtypes.Peer_TcpAddress:
+		address = conn.TcpAddress
+	case *launchtypes.Peer_HttpTunnel:
+		ports, err := availableport.Find(1)
+		if err != nil {
+			return err
+		}
+		localPort := strconv.Itoa(ports[0])
+		address = net.JoinHostPort("127.0.0.1", localPort)
+
+		go xchisel.StartClient(ctx, conn.HttpTunnel.Address, localPort, tunnelP2PPort)
+	default:
+		return fmt.Errorf("invalid peer connection type: %T", peer.Connection)
+	}
+
+	var (
+		conn   net.Conn
+		dialer net.Dialer
+	)
+	dial := func() (err error) {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+		return err
+	}
+
+	var err error
+	if _, ok := peer.Connection.(*launchtypes.Peer_HttpTunnel); ok {
+		// the tunnel client listens asynchronously, the dial is retried until it does.
+		err = backoff.Retry(dial, backoff.WithContext(backoff.NewConstantBackOff(100*time.Millisecond), ctx))
+	} else {
+		err = dial()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "peer %s is not reachable", peer.Id)
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	// the secret connection handshake authenticates the node key of the peer.
+	sc, err := tmconn.MakeSecretConnection(conn, ed25519.GenPrivKey())
+	if err != nil {
+		return errors.Wrapf(err, "peer %s handshake failed", peer.Id)
+	}
+	if nodeID := string(p2p.PubKeyToID(sc.RemotePubKey())); nodeID != strings.ToLower(peer.Id) {
+		return fmt.Errorf("peer %s answered with the node ID %s", peer.Id, nodeID)
+	}
+
+	return nil
+}
@@ -1,11 +1,16 @@
 package network
 
 import (
+	"context"
 	"errors"
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
+	tmconn "github.com/tendermint/tendermint/p2p/conn"
 )
 
 func TestPeerAddress(t *testing.T) {
@@ -44,3 +49,69 @@ func TestPeerAddress(t *testing.T) {
 		})
 	}
 }
+
+// servePeer serves a node that answers the P2P handshakes with its node key and
+// returns its node ID and address.
+func servePeer(t *testing.T) (nodeID, address string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	nodeKey := ed25519.GenPrivKey()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				tmconn.MakeSecretConnection(conn, nodeKey)
+			}()
+		}
+	}()
+
+	return string(p2p.PubKeyToID(nodeKey.PubKey())), listener.Addr().String()
+}
+
+func TestVerifyPeer(t *testing.T) {
+	nodeID, address := servePeer(t)
+
+	tests := []struct {
+		name string
+		peer launchtypes.Peer
+		err  string
+	}{
+		{
+			name: "valid peer",
+			peer: launchtypes.NewPeerConn(nodeID, address),
+		},
+		{
+			name: "wrong node id",
+			peer: launchtypes.NewPeerConn("nodeid", address),
+			err:  "peer nodeid answered with the node ID " + nodeID,
+		},
+		{
+			name: "unreachable peer",
+			peer: launchtypes.NewPeerConn(nodeID, "127.0.0.1:1"),
+			err:  "peer " + nodeID + " is not reachable",
+		},
+		{
+			name: "invalid peer",
+			peer: launchtypes.Peer{Id: "invalid-peer", Connection: nil},
+			err:  "invalid peer connection type: <nil>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPeer(context.Background(), tt.peer)
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -244,22 +244,19 @@ func (n Network) sendAccountRequest(
 	)
 
 	n.ev.Send(events.New(events.StatusOngoing, "Broadcasting account transactions"))
-	res, err := n.cosmos.BroadcastTx(n.account.Name, msg)
+	requestPayload, err := n.sendRequestMsg(launchID, msg, &launchtypes.MsgRequestAddAccountResponse{})
 	if err != nil {
 		return err
 	}
 
-	var requestRes launchtypes.MsgRequestAddAccountResponse
-	if err := res.Decode(&requestRes); err != nil {
-		return err
-	}
-
-	if requestRes.AutoApproved {
-		n.ev.Send(events.New(events.StatusDone, "Account added to the network by the coordinator!"))
+	payload := events.Payload(requestPayload)
+	if requestPayload.AutoApproved {
+		n.ev.Send(events.New(events.StatusDone, "Account added to the network by the coordinator!", payload))
 	} else {
 		n.ev.Send(events.New(events.StatusDone,
 			fmt.Sprintf("Request %d to add account to the network has been submitted!",
-				requestRes.RequestID),
+				requestPayload.RequestID),
+			payload,
 		))
 	}
 	return nil
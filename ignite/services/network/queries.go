@@ -2,9 +2,13 @@ package network
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"sync"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/pkg/errors"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
@@ -185,6 +189,71 @@ func (n Network) MainnetAccounts(ctx context.Context, campaignID uint64) (genAcc
 	return genAccs, nil
 }
 
+// MainnetAccount returns the campaign mainnet account of an address from SPN
+func (n Network) MainnetAccount(
+	ctx context.Context,
+	campaignID uint64,
+	address string,
+) (acc networktypes.MainnetAccount, err error) {
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Fetching campaign %d mainnet account %s", campaignID, address)))
+	res, err := n.campaignQuery.
+		MainnetAccount(ctx,
+			&campaigntypes.QueryGetMainnetAccountRequest{
+				CampaignID: campaignID,
+				Address:    address,
+			},
+		)
+	if cosmoserror.Unwrap(err) == cosmoserror.ErrNotFound {
+		return acc, ErrObjectNotFound
+	} else if err != nil {
+		return acc, err
+	}
+
+	return networktypes.ToMainnetAccount(res.MainnetAccount), nil
+}
+
+// CampaignVouchers returns the vouchers of a campaign in the balance of an address from SPN
+func (n Network) CampaignVouchers(ctx context.Context, campaignID uint64, address string) (sdk.Coins, error) {
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Fetching campaign %d vouchers of %s", campaignID, address)))
+
+	var (
+		vouchers sdk.Coins
+		page     = &query.PageRequest{}
+	)
+	for {
+		res, err := n.bankQuery.
+			AllBalances(ctx,
+				&banktypes.QueryAllBalancesRequest{
+					Address:    address,
+					Pagination: page,
+				},
+			)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, coin := range res.Balances {
+			if campaigntypes.CheckVouchers(sdk.NewCoins(coin), campaignID) == nil {
+				vouchers = append(vouchers, coin)
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return vouchers, nil
+		}
+		page = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// TotalShares returns the number of shares of each denom that make up the total supply of a campaign
+func (n Network) TotalShares(ctx context.Context) (uint64, error) {
+	res, err := n.campaignQuery.TotalShares(ctx, &campaigntypes.QueryTotalSharesRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.TotalShares, nil
+}
+
 // ChainReward fetches the chain reward from SPN by launch id
 func (n Network) ChainReward(ctx context.Context, launchID uint64) (rewardtypes.RewardPool, error) {
 	res, err := n.rewardQuery.
@@ -0,0 +1,199 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/gogo/protobuf/proto"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/pkg/events"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+// requestResponse is the response of the messages that send a request.
+type requestResponse interface {
+	proto.Message
+	GetRequestID() uint64
+	GetAutoApproved() bool
+}
+
+// newRequestMsg returns the message that sends a request with the content for a chain launch
+// and the response of the message.
+func newRequestMsg(creator string, launchID uint64, content launchtypes.RequestContent) (sdk.Msg, requestResponse, error) {
+	switch c := content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		return launchtypes.NewMsgRequestAddAccount(
+			creator,
+			launchID,
+			c.GenesisAccount.Address,
+			c.GenesisAccount.Coins,
+		), &launchtypes.MsgRequestAddAccountResponse{}, nil
+	case *launchtypes.RequestContent_VestingAccount:
+		return launchtypes.NewMsgRequestAddVestingAccount(
+			creator,
+			launchID,
+			c.VestingAccount.Address,
+			c.VestingAccount.VestingOptions,
+		), &launchtypes.MsgRequestAddVestingAccountResponse{}, nil
+	case *launchtypes.RequestContent_GenesisValidator:
+		return launchtypes.NewMsgRequestAddValidator(
+			creator,
+			launchID,
+			c.GenesisValidator.Address,
+			c.GenesisValidator.GenTx,
+			c.GenesisValidator.ConsPubKey,
+			c.GenesisValidator.SelfDelegation,
+			c.GenesisValidator.Peer,
+		), &launchtypes.MsgRequestAddValidatorResponse{}, nil
+	case *launchtypes.RequestContent_AccountRemoval:
+		return launchtypes.NewMsgRequestRemoveAccount(
+			creator,
+			launchID,
+			c.AccountRemoval.Address,
+		), &launchtypes.MsgRequestRemoveAccountResponse{}, nil
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		return launchtypes.NewMsgRequestRemoveValidator(
+			creator,
+			launchID,
+			c.ValidatorRemoval.ValAddress,
+		), &launchtypes.MsgRequestRemoveValidatorResponse{}, nil
+	default:
+		return nil, nil, fmt.Errorf("unrecognized request content %T", content.Content)
+	}
+}
+
+// VerifyRequestContent verifies the content of a request and checks that it can be applied
+// to the current genesis information of the chain launch.
+func (n Network) VerifyRequestContent(ctx context.Context, launchID uint64, content launchtypes.RequestContent) error {
+	if err := validateRequestContent(content); err != nil {
+		return err
+	}
+
+	request := networktypes.Request{
+		LaunchID: launchID,
+		Content:  content,
+	}
+	if err := networktypes.VerifyRequest(request); err != nil {
+		return err
+	}
+
+	gi, err := n.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if _, err := gi.ApplyRequest(request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateRequestContent validates the content of a request, the addresses of the content
+// must be SPN addresses.
+func validateRequestContent(content launchtypes.RequestContent) error {
+	var address string
+	switch c := content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		address = c.GenesisAccount.Address
+		if c.GenesisAccount.Coins.Empty() {
+			return fmt.Errorf("no coins for the account %s", address)
+		}
+		if !c.GenesisAccount.Coins.IsValid() {
+			return fmt.Errorf("invalid coins %s", c.GenesisAccount.Coins)
+		}
+	case *launchtypes.RequestContent_VestingAccount:
+		address = c.VestingAccount.Address
+		if err := c.VestingAccount.VestingOptions.Validate(); err != nil {
+			return err
+		}
+	case *launchtypes.RequestContent_GenesisValidator:
+		address = c.GenesisValidator.Address
+	case *launchtypes.RequestContent_AccountRemoval:
+		address = c.AccountRemoval.Address
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		address = c.ValidatorRemoval.ValAddress
+	default:
+		return fmt.Errorf("unrecognized request content %T", content.Content)
+	}
+
+	prefix, err := cosmosutil.GetAddressPrefix(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %s: %w", address, err)
+	}
+	if prefix != networktypes.SPN {
+		return fmt.Errorf("invalid address %s: the prefix must be %s", address, networktypes.SPN)
+	}
+	return nil
+}
+
+// SendRequest sends a request with the content for a chain launch.
+func (n Network) SendRequest(launchID uint64, content launchtypes.RequestContent) (networktypes.RequestPayload, error) {
+	msg, requestRes, err := newRequestMsg(n.account.Address(networktypes.SPN), launchID, content)
+	if err != nil {
+		return networktypes.RequestPayload{}, err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, "Broadcasting the request"))
+	payload, err := n.sendRequestMsg(launchID, msg, requestRes)
+	if err != nil {
+		return networktypes.RequestPayload{}, err
+	}
+
+	if payload.AutoApproved {
+		n.ev.Send(events.New(events.StatusDone,
+			fmt.Sprintf("Request %d approved by the coordinator!", payload.RequestID),
+			events.Payload(payload),
+		))
+	} else {
+		n.ev.Send(events.New(events.StatusDone,
+			fmt.Sprintf("Request %d has been submitted!", payload.RequestID),
+			events.Payload(payload),
+		))
+	}
+	return payload, nil
+}
+
+// sendRequestMsg broadcasts msg that sends a request for a chain launch, decodes the
+// response of the message into res and returns the payload of the request.
+func (n Network) sendRequestMsg(launchID uint64, msg sdk.Msg, res requestResponse) (networktypes.RequestPayload, error) {
+	txRes, err := n.cosmos.BroadcastTx(n.account.Name, msg)
+	if err != nil {
+		return networktypes.RequestPayload{}, err
+	}
+	if err := txRes.Decode(res); err != nil {
+		return networktypes.RequestPayload{}, err
+	}
+
+	return networktypes.RequestPayload{
+		LaunchID:     launchID,
+		RequestID:    res.GetRequestID(),
+		AutoApproved: res.GetAutoApproved(),
+	}, nil
+}
+
+// RequestTx returns the unsigned transaction in JSON that sends a request with the content
+// for a chain launch.
+func (n Network) RequestTx(launchID uint64, content launchtypes.RequestContent) ([]byte, error) {
+	msg, _, err := newRequestMsg(n.account.Address(networktypes.SPN), launchID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	launchtypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	builder := txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return nil, err
+	}
+	return txConfig.TxJSONEncoder()(builder.GetTx())
+}
@@ -0,0 +1,152 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+	"github.com/ignite-hq/cli/ignite/services/network/testutil"
+)
+
+const requestTestAddress = "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g"
+
+func TestVerifyRequestContent(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		coins          = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	)
+
+	suite.LaunchQueryMock.
+		On("GenesisAccountAll", context.Background(), &launchtypes.QueryAllGenesisAccountRequest{
+			LaunchID: testutil.LaunchID,
+		}).
+		Return(&launchtypes.QueryAllGenesisAccountResponse{
+			GenesisAccount: []launchtypes.GenesisAccount{
+				{LaunchID: testutil.LaunchID, Address: requestTestAddress, Coins: coins},
+			},
+		}, nil)
+	suite.LaunchQueryMock.
+		On("VestingAccountAll", context.Background(), &launchtypes.QueryAllVestingAccountRequest{
+			LaunchID: testutil.LaunchID,
+		}).
+		Return(&launchtypes.QueryAllVestingAccountResponse{}, nil)
+	suite.LaunchQueryMock.
+		On("GenesisValidatorAll", context.Background(), &launchtypes.QueryAllGenesisValidatorRequest{
+			LaunchID: testutil.LaunchID,
+		}).
+		Return(&launchtypes.QueryAllGenesisValidatorResponse{}, nil)
+
+	tests := []struct {
+		name    string
+		content launchtypes.RequestContent
+		err     string
+	}{
+		{
+			name:    "add account",
+			content: launchtypes.NewGenesisAccount(testutil.LaunchID, account.Address(networktypes.SPN), coins),
+		},
+		{
+			name:    "remove account",
+			content: launchtypes.NewAccountRemoval(requestTestAddress),
+		},
+		{
+			name:    "existing account",
+			content: launchtypes.NewGenesisAccount(testutil.LaunchID, requestTestAddress, coins),
+			err:     "genesis account already in genesis: request 0 is invalid",
+		},
+		{
+			name: "existing vesting account",
+			content: launchtypes.NewVestingAccount(
+				testutil.LaunchID,
+				requestTestAddress,
+				*launchtypes.NewDelayedVesting(coins, coins, 1),
+			),
+			err: "vesting account already in genesis: request 0 is invalid",
+		},
+		{
+			name:    "unknown account removal",
+			content: launchtypes.NewAccountRemoval(account.Address(networktypes.SPN)),
+			err:     "account can't be removed because it doesn't exist: request 0 is invalid",
+		},
+		{
+			name:    "unknown validator removal",
+			content: launchtypes.NewValidatorRemoval(requestTestAddress),
+			err:     "genesis validator can't be removed because it doesn't exist: request 0 is invalid",
+		},
+		{
+			name:    "invalid content",
+			content: launchtypes.NewGenesisAccount(testutil.LaunchID, "invalid", coins),
+			err:     "invalid address invalid: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:    "not a SPN address",
+			content: launchtypes.NewAccountRemoval("cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"),
+			err:     "invalid address cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj: the prefix must be spn",
+		},
+		{
+			name:    "invalid coins",
+			content: launchtypes.NewGenesisAccount(testutil.LaunchID, account.Address(networktypes.SPN), nil),
+			err:     "no coins for the account " + account.Address(networktypes.SPN),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := network.VerifyRequestContent(context.Background(), testutil.LaunchID, tt.content)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSendRequest(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+	)
+
+	suite.CosmosClientMock.
+		On(
+			"BroadcastTx",
+			account.Name,
+			launchtypes.NewMsgRequestRemoveAccount(
+				account.Address(networktypes.SPN),
+				testutil.LaunchID,
+				requestTestAddress,
+			),
+		).
+		Return(testutil.NewResponse(&launchtypes.MsgRequestRemoveAccountResponse{
+			RequestID:    3,
+			AutoApproved: true,
+		}), nil).
+		Once()
+
+	payload, err := network.SendRequest(testutil.LaunchID, launchtypes.NewAccountRemoval(requestTestAddress))
+	require.NoError(t, err)
+	require.Equal(t, networktypes.RequestPayload{
+		LaunchID:     testutil.LaunchID,
+		RequestID:    3,
+		AutoApproved: true,
+	}, payload)
+
+	suite.AssertAllMocks(t)
+}
+
+func TestRequestTx(t *testing.T) {
+	var (
+		account    = testutil.NewTestAccount(t, testutil.TestAccountName)
+		_, network = newSuite(account)
+	)
+
+	tx, err := network.RequestTx(testutil.LaunchID, launchtypes.NewValidatorRemoval(requestTestAddress))
+	require.NoError(t, err)
+	require.Contains(t, string(tx), `"@type":"/tendermint.spn.launch.MsgRequestRemoveValidator"`)
+	require.Contains(t, string(tx), `"validatorAddress":"`+requestTestAddress+`"`)
+}
@@ -0,0 +1,173 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/goccy/go-yaml"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+// ReviewPolicy holds the rules to automatically review the requests of a chain.
+type ReviewPolicy struct {
+	// MaxSelfDelegation is the maximum self delegation of the validators, e.g. "100000stake".
+	MaxSelfDelegation string `yaml:"max_self_delegation"`
+
+	// AllowedAccounts are the only accounts that can be added to the genesis or join as
+	// validators when they are set.
+	AllowedAccounts []string `yaml:"allowed_accounts"`
+
+	// RequirePeerReachable rejects the validators whose peers cannot be dialed or
+	// don't answer the P2P handshake with their node ID.
+	RequirePeerReachable bool `yaml:"require_peer_reachable"`
+
+	// MaxValidators is the maximum number of genesis validators when it is set.
+	MaxValidators int `yaml:"max_validators"`
+}
+
+// ParseReviewPolicy parses the review policy in the YAML file at path.
+func ParseReviewPolicy(path string) (ReviewPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ReviewPolicy{}, err
+	}
+
+	var policy ReviewPolicy
+	if err := yaml.UnmarshalWithOptions(data, &policy, yaml.Strict()); err != nil {
+		return ReviewPolicy{}, fmt.Errorf("invalid review policy %s: %w", path, err)
+	}
+
+	if policy.MaxSelfDelegation != "" {
+		if _, err := sdk.ParseCoinNormalized(policy.MaxSelfDelegation); err != nil {
+			return ReviewPolicy{}, fmt.Errorf("invalid max self delegation: %w", err)
+		}
+	}
+	for i, address := range policy.AllowedAccounts {
+		// requests use SPN addresses.
+		if policy.AllowedAccounts[i], err = cosmosutil.ChangeAddressPrefix(address, networktypes.SPN); err != nil {
+			return ReviewPolicy{}, fmt.Errorf("invalid allowed account %s: %w", address, err)
+		}
+	}
+
+	return policy, nil
+}
+
+// RequestReview is the review of a request by a policy.
+type RequestReview struct {
+	Reviewal
+
+	// Skipped is true when the request cannot be reviewed by the policy and is
+	// left for a manual review.
+	Skipped bool
+
+	// Reason explains the review.
+	Reason string
+}
+
+// ReviewRequests applies policy to requests. validators is the number of validators
+// already in the genesis, approved validator requests are counted in the order of requests.
+// the requests are not verified and must be simulated before they are approved.
+func ReviewRequests(
+	ctx context.Context,
+	policy ReviewPolicy,
+	validators int,
+	requests []networktypes.Request,
+) []RequestReview {
+	reviews := make([]RequestReview, 0, len(requests))
+
+	for _, request := range requests {
+		review := policy.review(ctx, request, validators)
+		if review.IsApproved && isValidatorRequest(request) {
+			validators++
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews
+}
+
+// review applies the policy to request.
+func (p ReviewPolicy) review(ctx context.Context, request networktypes.Request, validators int) RequestReview {
+	approve := func(reason string) RequestReview {
+		return RequestReview{Reviewal: ApproveRequest(request.RequestID), Reason: reason}
+	}
+	reject := func(format string, a ...interface{}) RequestReview {
+		return RequestReview{Reviewal: RejectRequest(request.RequestID), Reason: fmt.Sprintf(format, a...)}
+	}
+
+	if err := networktypes.VerifyRequest(request); err != nil {
+		return reject("invalid request: %s", err)
+	}
+
+	var (
+		address   string
+		validator *launchtypes.GenesisValidator
+	)
+
+	switch req := request.Content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		address = req.GenesisAccount.Address
+	case *launchtypes.RequestContent_VestingAccount:
+		address = req.VestingAccount.Address
+	case *launchtypes.RequestContent_GenesisValidator:
+		address = req.GenesisValidator.Address
+		validator = req.GenesisValidator
+	default:
+		return RequestReview{
+			Reviewal: Reviewal{RequestID: request.RequestID},
+			Skipped:  true,
+			Reason:   "removal requests are not reviewed by the policy",
+		}
+	}
+
+	if len(p.AllowedAccounts) > 0 && !contains(p.AllowedAccounts, address) {
+		return reject("account %s is not allowed", address)
+	}
+
+	if validator == nil {
+		return approve("the request follows the policy")
+	}
+
+	if p.MaxValidators > 0 && validators >= p.MaxValidators {
+		return reject("the chain already has the maximum of %d validators", p.MaxValidators)
+	}
+
+	if p.MaxSelfDelegation != "" {
+		// the max self delegation is validated when the policy is parsed.
+		max, _ := sdk.ParseCoinNormalized(p.MaxSelfDelegation)
+		if validator.SelfDelegation.Denom != max.Denom {
+			return reject("self delegation %s is not in %s", validator.SelfDelegation, max.Denom)
+		}
+		if validator.SelfDelegation.Amount.GT(max.Amount) {
+			return reject("self delegation %s is greater than %s", validator.SelfDelegation, max)
+		}
+	}
+
+	if p.RequirePeerReachable {
+		if err := VerifyPeer(ctx, validator.Peer); err != nil {
+			return reject("%s", err)
+		}
+	}
+
+	return approve("the request follows the policy")
+}
+
+// isValidatorRequest checks if request adds a genesis validator.
+func isValidatorRequest(request networktypes.Request) bool {
+	_, ok := request.Content.Content.(*launchtypes.RequestContent_GenesisValidator)
+	return ok
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
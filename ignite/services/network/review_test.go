@@ -0,0 +1,209 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+const (
+	reviewTestAddress      = "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g"
+	reviewTestOtherAddress = "spn1sgphx4vxt63xhvgp9wpewajyxeqt04twfptdcv"
+)
+
+func reviewTestValidatorRequest(t *testing.T, id uint64, peer launchtypes.Peer) networktypes.Request {
+	gentx := []byte(`{
+  "body": {
+    "messages": [
+      {
+        "delegator_address": "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+        "pubkey": {
+          "@type": "/cosmos.crypto.ed25519.PubKey",
+          "key": "aeQLCJOjXUyB7evOodI4mbrshIt3vhHGlycJDbUkaMs="
+        },
+        "validator_address": "cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup",
+        "value": {
+          "amount": "95000000",
+          "denom": "stake"
+        }
+      }
+    ]
+  }
+}`)
+	pk, err := base64.StdEncoding.DecodeString("aeQLCJOjXUyB7evOodI4mbrshIt3vhHGlycJDbUkaMs=")
+	require.NoError(t, err)
+
+	return networktypes.Request{
+		RequestID: id,
+		Content: launchtypes.RequestContent{
+			Content: &launchtypes.RequestContent_GenesisValidator{
+				GenesisValidator: &launchtypes.GenesisValidator{
+					Address:        reviewTestAddress,
+					GenTx:          gentx,
+					ConsPubKey:     ed25519.PubKey(pk),
+					SelfDelegation: sdk.NewCoin("stake", sdk.NewInt(95000000)),
+					Peer:           peer,
+				},
+			},
+		},
+	}
+}
+
+func reviewTestAccountRequest(id uint64, address string) networktypes.Request {
+	return networktypes.Request{
+		RequestID: id,
+		Content: launchtypes.NewGenesisAccount(
+			0,
+			address,
+			sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))),
+		),
+	}
+}
+
+func TestReviewRequests(t *testing.T) {
+	nodeID, address := servePeer(t)
+
+	var (
+		reachable   = launchtypes.NewPeerConn(nodeID, address)
+		wrongNodeID = launchtypes.NewPeerConn("nodeid", address)
+		unreachable = launchtypes.NewPeerConn("nodeid", "127.0.0.1:1")
+		removal     = networktypes.Request{
+			RequestID: 9,
+			Content:   launchtypes.NewAccountRemoval(reviewTestAddress),
+		}
+	)
+
+	tests := []struct {
+		name       string
+		policy     ReviewPolicy
+		validators int
+		requests   []networktypes.Request
+		want       []RequestReview
+	}{
+		{
+			name:   "allowed accounts",
+			policy: ReviewPolicy{AllowedAccounts: []string{reviewTestAddress}},
+			requests: []networktypes.Request{
+				reviewTestAccountRequest(1, reviewTestAddress),
+				reviewTestAccountRequest(2, reviewTestOtherAddress),
+			},
+			want: []RequestReview{
+				{Reviewal: ApproveRequest(1), Reason: "the request follows the policy"},
+				{Reviewal: RejectRequest(2), Reason: "account " + reviewTestOtherAddress + " is not allowed"},
+			},
+		},
+		{
+			name:   "max self delegation",
+			policy: ReviewPolicy{MaxSelfDelegation: "90000000stake"},
+			requests: []networktypes.Request{
+				reviewTestValidatorRequest(t, 1, reachable),
+			},
+			want: []RequestReview{
+				{Reviewal: RejectRequest(1), Reason: "self delegation 95000000stake is greater than 90000000stake"},
+			},
+		},
+		{
+			name:       "max validators",
+			policy:     ReviewPolicy{MaxValidators: 2},
+			validators: 1,
+			requests: []networktypes.Request{
+				reviewTestValidatorRequest(t, 1, reachable),
+				reviewTestAccountRequest(2, reviewTestAddress),
+				reviewTestValidatorRequest(t, 3, reachable),
+			},
+			want: []RequestReview{
+				{Reviewal: ApproveRequest(1), Reason: "the request follows the policy"},
+				{Reviewal: ApproveRequest(2), Reason: "the request follows the policy"},
+				{Reviewal: RejectRequest(3), Reason: "the chain already has the maximum of 2 validators"},
+			},
+		},
+		{
+			name:   "peer reachability",
+			policy: ReviewPolicy{RequirePeerReachable: true},
+			requests: []networktypes.Request{
+				reviewTestValidatorRequest(t, 1, reachable),
+			},
+			want: []RequestReview{
+				{Reviewal: ApproveRequest(1), Reason: "the request follows the policy"},
+			},
+		},
+		{
+			name:     "removals are skipped",
+			requests: []networktypes.Request{removal},
+			want: []RequestReview{
+				{
+					Reviewal: Reviewal{RequestID: 9},
+					Skipped:  true,
+					Reason:   "removal requests are not reviewed by the policy",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReviewRequests(context.Background(), tt.policy, tt.validators, tt.requests)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("unreachable peer", func(t *testing.T) {
+		got := ReviewRequests(
+			context.Background(),
+			ReviewPolicy{RequirePeerReachable: true},
+			0,
+			[]networktypes.Request{reviewTestValidatorRequest(t, 1, unreachable)},
+		)
+		require.Len(t, got, 1)
+		require.False(t, got[0].IsApproved)
+		require.Contains(t, got[0].Reason, "peer nodeid is not reachable")
+	})
+
+	t.Run("peer with another node ID", func(t *testing.T) {
+		got := ReviewRequests(
+			context.Background(),
+			ReviewPolicy{RequirePeerReachable: true},
+			0,
+			[]networktypes.Request{reviewTestValidatorRequest(t, 1, wrongNodeID)},
+		)
+		require.Len(t, got, 1)
+		require.False(t, got[0].IsApproved)
+		require.Contains(t, got[0].Reason, "peer nodeid answered with the node ID "+nodeID)
+	})
+}
+
+func TestParseReviewPolicy(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "policy.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+max_self_delegation: 100000000stake
+allowed_accounts:
+  - cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj
+require_peer_reachable: true
+max_validators: 10
+`), 0644))
+
+	policy, err := ParseReviewPolicy(path)
+	require.NoError(t, err)
+	require.Equal(t, ReviewPolicy{
+		MaxSelfDelegation:    "100000000stake",
+		AllowedAccounts:      []string{reviewTestAddress},
+		RequirePeerReachable: true,
+		MaxValidators:        10,
+	}, policy)
+
+	invalid := filepath.Join(dir, "invalid.yml")
+	require.NoError(t, os.WriteFile(invalid, []byte("max_validator: 10\n"), 0644))
+
+	_, err = ParseReviewPolicy(invalid)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -28,6 +29,11 @@ type RewardClient interface {
 	rewardtypes.QueryClient
 }
 
+//go:generate mockery --name BankClient --case underscore --output ../mocks
+type BankClient interface {
+	banktypes.QueryClient
+}
+
 //go:generate mockery --name AccountInfo --case underscore --output ../mocks
 type AccountInfo interface {
 	keyring.Info